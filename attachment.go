@@ -0,0 +1,113 @@
+package hierr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AttachmentPrefix is a prefix of attachments, which are rendered as
+// branches at the end of the error tree.
+var AttachmentPrefix = "attachment: "
+
+// MaxAttachmentSize is a maximum size of attachment data in bytes, which is
+// kept by WithAttachment(), longer data is truncated. Non-positive value
+// disables truncation.
+var MaxAttachmentSize = 64 * 1024
+
+// Attachment is a named byte payload, like captured stderr or body of HTTP
+// response, which is attached to the error instead of being dumped into
+// its message.
+type Attachment struct {
+	// Name is a name of the attachment, like "stderr".
+	Name string
+
+	// Data is a content of the attachment, which is truncated to
+	// MaxAttachmentSize bytes.
+	Data []byte
+
+	// Size is an original size of the content in bytes.
+	Size int
+}
+
+// Truncated returns true if data of the attachment is shorter than its
+// original content.
+func (attachment Attachment) Truncated() bool {
+	return len(attachment.Data) < attachment.Size
+}
+
+// WithAttachment returns copy of the error with given named payload
+// attached. Attachments are rendered folded after nested errors, verbose
+// representation, which is returned for %+v verb, shows their contents:
+//
+//	can't run git fetch
+//	├─ exit status 128
+//	└─ attachment: stderr, 4.2KiB
+//
+// Data is copied and truncated to MaxAttachmentSize bytes.
+func (err Error) WithAttachment(name string, data []byte) Error {
+	attachment := Attachment{Name: name, Size: len(data)}
+
+	if limit := GetConfig().MaxAttachmentSize; limit > 0 && len(data) > limit {
+		data = data[:limit]
+	}
+
+	attachment.Data = append([]byte{}, data...)
+
+	attachments := append(err.GetAttachments(), attachment)
+	err.attachments = &attachments
+
+	return err
+}
+
+// GetAttachments returns attachments of the error.
+func (err Error) GetAttachments() []Attachment {
+	if err.attachments == nil {
+		return nil
+	}
+
+	return append([]Attachment{}, *err.attachments...)
+}
+
+// formatAttachments returns attachments of the error as nested branches,
+// contents of attachments are nested into them in verbose mode.
+func formatAttachments(
+	attachments []Attachment,
+	options rendering,
+) []NestedError {
+	branches := []NestedError{}
+	for _, attachment := range attachments {
+		header := options.attachment + attachment.Name + ", " +
+			formatSize(attachment.Size)
+
+		if attachment.Truncated() {
+			header += ", truncated to " + formatSize(len(attachment.Data))
+		}
+
+		content := strings.TrimRight(string(attachment.Data), "\n")
+		if !options.verbose || content == "" {
+			branches = append(branches, header)
+			continue
+		}
+
+		branches = append(branches, Error{Message: header, Nested: content})
+	}
+
+	return branches
+}
+
+// formatSize returns given size in bytes in binary units, like "4.2KiB".
+func formatSize(size int) string {
+	if size < 1024 {
+		return fmt.Sprintf("%dB", size)
+	}
+
+	value := float64(size)
+	for _, unit := range []string{"KiB", "MiB", "GiB"} {
+		value /= 1024
+		if value < 1024 || unit == "GiB" {
+			return strings.TrimSuffix(fmt.Sprintf("%.1f", value), ".0") + unit
+		}
+	}
+
+	return ""
+}
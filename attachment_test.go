@@ -0,0 +1,44 @@
+package hierr
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+func ExampleError_WithAttachment() {
+	err := Errorf(errors.New("exit status 128"), "can't run git fetch").(Error).
+		WithAttachment("stderr", []byte("fatal: repository not found\n")).
+		WithAttachment("stdout", bytes.Repeat([]byte("x"), 4300))
+
+	fmt.Println(err)
+
+	// Output:
+	// can't run git fetch
+	// ├─ exit status 128
+	// ├─ attachment: stderr, 28B
+	// └─ attachment: stdout, 4.2KiB
+}
+
+func ExampleError_WithAttachment_verbose() {
+	defer func(size int) { MaxAttachmentSize = size }(MaxAttachmentSize)
+
+	MaxAttachmentSize = 10
+
+	err := NewWith(
+		"can't call api",
+		WithAttachment("body", []byte("line 1\nline 2")),
+		WithAttachment("trace", []byte("0123456789abcdef")),
+	)
+
+	fmt.Printf("%+v\n", err)
+
+	// Output:
+	// can't call api
+	// ├─ attachment: body, 13B, truncated to 10B
+	// │  └─ line 1
+	// │     lin
+	// │
+	// └─ attachment: trace, 16B, truncated to 10B
+	//    └─ 0123456789
+}
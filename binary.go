@@ -0,0 +1,183 @@
+package hierr
+
+import (
+	"bytes"
+	"encoding/gob"
+	"time"
+)
+
+func init() {
+	// Register error, so it can be gob-encoded as value of error interface,
+	// like field of struct, which is sent over net/rpc.
+	gob.Register(Error{})
+}
+
+// encodedNode represents node of the error tree in serializable form.
+type encodedNode struct {
+	Message     string
+	Public      string
+	Caller      *Caller
+	ID          string
+	Severity    Severity
+	Visibility  Visibility
+	Hints       []string
+	Tags        []string
+	Attachments []Attachment
+	Time        time.Time
+	Nested      []encodedNested
+}
+
+// encodedNested represents either context pair or nested reason.
+type encodedNested struct {
+	Field  *encodedField
+	Reason *encodedNode
+}
+
+// encodedField represents context pair, which value is kept as is if it's
+// of basic type and converted to string otherwise.
+type encodedField struct {
+	Key   string
+	Value interface{}
+}
+
+// MarshalBinary encodes the whole error tree, including context pairs,
+// callers and metadata of nodes, like IDs, severities, hints, tags,
+// attachments, public messages, visibilities and creation times, using
+// encoding/gob, so error can be sent over net/rpc or stored in queues
+// without converting it into string. Values of context pairs, which are not
+// of basic types, are converted to strings. Call stacks are not encoded,
+// since they're valid only in the process, where they're captured.
+func (err Error) MarshalBinary() ([]byte, error) {
+	buffer := bytes.Buffer{}
+
+	encodeErr := gob.NewEncoder(&buffer).Encode(encodeNode(err))
+	if encodeErr != nil {
+		return nil, encodeErr
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalBinary decodes error tree, encoded by MarshalBinary(). Nested
+// reasons are decoded as hierr.Error.
+func (err *Error) UnmarshalBinary(data []byte) error {
+	node := encodedNode{}
+
+	decodeErr := gob.NewDecoder(bytes.NewReader(data)).Decode(&node)
+	if decodeErr != nil {
+		return decodeErr
+	}
+
+	*err = decodeNode(node)
+
+	return nil
+}
+
+func encodeNode(node NestedError) encodedNode {
+	node = dereference(node)
+
+	if decomposed, ok := decompose(node); ok {
+		node = decomposed
+	}
+
+	hierarchical, ok := node.(HierarchicalError)
+	if !ok {
+		return encodedNode{Message: String(node)}
+	}
+
+	encoded := encodedNode{Message: hierarchical.GetMessage()}
+
+	if err, ok := node.(Error); ok {
+		if err.Caller != nil {
+			caller := *err.Caller
+			encoded.Caller = &caller
+		}
+
+		encoded.ID = err.ID
+		encoded.Severity = err.Severity
+		encoded.Hints = err.GetHints()
+		encoded.Tags = err.GetTags()
+		encoded.Attachments = err.GetAttachments()
+		encoded.Public = err.PublicMessage
+		encoded.Visibility = err.Visibility
+		encoded.Time = err.Time
+	}
+
+	for _, nested := range hierarchical.GetNested() {
+		if field, ok := getField(nested); ok {
+			encoded.Nested = append(encoded.Nested, encodedNested{
+				Field: &encodedField{
+					Key:   field.Key,
+					Value: encodeValue(field.Value),
+				},
+			})
+
+			continue
+		}
+
+		reason := encodeNode(nested)
+
+		encoded.Nested = append(encoded.Nested, encodedNested{
+			Reason: &reason,
+		})
+	}
+
+	return encoded
+}
+
+func decodeNode(node encodedNode) Error {
+	err := Error{
+		Message:       node.Message,
+		Caller:        node.Caller,
+		ID:            node.ID,
+		Severity:      node.Severity,
+		PublicMessage: node.Public,
+		Visibility:    node.Visibility,
+		Time:          node.Time,
+	}
+
+	if len(node.Hints) > 0 {
+		hints := node.Hints
+		err.hints = &hints
+	}
+
+	if len(node.Tags) > 0 {
+		err = err.WithTags(node.Tags...)
+	}
+
+	if len(node.Attachments) > 0 {
+		attachments := node.Attachments
+		err.attachments = &attachments
+	}
+
+	if len(node.Nested) == 0 {
+		return err
+	}
+
+	nested := []NestedError{}
+	for _, child := range node.Nested {
+		switch {
+		case child.Field != nil:
+			nested = append(nested, Context(child.Field.Key, child.Field.Value))
+
+		case child.Reason != nil:
+			nested = append(nested, decodeNode(*child.Reason))
+		}
+	}
+
+	err.Nested = nested
+
+	return err
+}
+
+func encodeValue(value interface{}) interface{} {
+	switch value.(type) {
+	case string, bool, []byte,
+		int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64, uintptr,
+		float32, float64, complex64, complex128:
+		return value
+	}
+
+	return String(value)
+}
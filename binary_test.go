@@ -0,0 +1,74 @@
+package hierr
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+)
+
+func ExampleError_MarshalBinary() {
+	original := Push(
+		"can't deploy",
+		Context(
+			Errorf(errors.New("connection refused"), "can't connect"),
+			Context("host", "example.com"),
+		),
+		Context("attempts", 3),
+	)
+
+	data, err := original.(Error).MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+
+	decoded := Error{}
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		panic(err)
+	}
+
+	fmt.Println(decoded)
+	fmt.Println(Equal(decoded, original))
+
+	value, _ := decoded.GetValue("attempts")
+	fmt.Printf("%T\n", value)
+
+	// Output:
+	// can't deploy
+	// ├─ can't connect
+	// │  ├─ connection refused
+	// │  │
+	// │  └─ host
+	// │     └─ example.com
+	// │
+	// └─ attempts
+	//    └─ 3
+	// true
+	// int
+}
+
+func ExampleError_MarshalBinary_gob() {
+	type reply struct {
+		Err error
+	}
+
+	buffer := bytes.Buffer{}
+
+	err := gob.NewEncoder(&buffer).Encode(reply{
+		Err: Errorf(errors.New("disk is full"), "can't write"),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	decoded := reply{}
+	if err := gob.NewDecoder(&buffer).Decode(&decoded); err != nil {
+		panic(err)
+	}
+
+	fmt.Println(decoded.Err)
+
+	// Output:
+	// can't write
+	// └─ disk is full
+}
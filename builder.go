@@ -0,0 +1,121 @@
+package hierr
+
+import (
+	"fmt"
+)
+
+// CodeKey is a key of context pair, which is added by Builder.Code().
+const CodeKey = "code"
+
+// Builder constructs hierarchy error with multiple reasons, context and
+// metadata in one expression:
+//
+//	hierr.Build("can't deploy").
+//		Reason(err1).
+//		Reason(err2).
+//		Context("host", host).
+//		Err()
+type Builder struct {
+	message  string
+	nested   []NestedError
+	stack    bool
+	severity Severity
+	tags     []string
+
+	attachments []Attachment
+}
+
+// Build starts construction of hierarchy error with given message.
+func Build(message string) *Builder {
+	return &Builder{message: message}
+}
+
+// Buildf starts construction of hierarchy error with formatted message.
+func Buildf(message string, args ...interface{}) *Builder {
+	return Build(fmt.Sprintf(message, args...))
+}
+
+// Reason adds given reason to the error, nil reasons are ignored.
+func (builder *Builder) Reason(reason NestedError) *Builder {
+	if reason != nil {
+		builder.nested = append(builder.nested, reason)
+	}
+
+	return builder
+}
+
+// Context adds context pair with given key and value to the error.
+func (builder *Builder) Context(key string, value interface{}) *Builder {
+	builder.nested = append(builder.nested, Context(key, value))
+
+	return builder
+}
+
+// Code adds context pair with CodeKey key and given code to the error.
+func (builder *Builder) Code(code interface{}) *Builder {
+	return builder.Context(CodeKey, code)
+}
+
+// Stack enables capturing of call stack, when error is created by Err().
+func (builder *Builder) Stack() *Builder {
+	builder.stack = true
+
+	return builder
+}
+
+// Severity sets severity of the error.
+func (builder *Builder) Severity(severity Severity) *Builder {
+	builder.severity = severity
+
+	return builder
+}
+
+// Retryable marks the error as retryable or not by adding context pair with
+// RetryableKey key.
+func (builder *Builder) Retryable(retryable bool) *Builder {
+	return builder.Context(RetryableKey, retryable)
+}
+
+// Tags adds given tags to the error.
+func (builder *Builder) Tags(tags ...string) *Builder {
+	builder.tags = append(builder.tags, tags...)
+
+	return builder
+}
+
+// Attach attaches named payload, like captured stderr, to the error.
+func (builder *Builder) Attach(name string, data []byte) *Builder {
+	builder.attachments = append(
+		builder.attachments, Attachment{Name: name, Data: data},
+	)
+
+	return builder
+}
+
+// Err creates hierarchy error. Builder can be reused after Err() is called,
+// since created errors don't share nested lists with it.
+func (builder *Builder) Err() error {
+	return builder.build(1)
+}
+
+// build creates hierarchy error, skipping specified number of frames beside
+// caller of build, when recording caller and capturing call stack.
+func (builder *Builder) build(skip int) Error {
+	var nested NestedError
+	if len(builder.nested) > 0 {
+		nested = append([]NestedError{}, builder.nested...)
+	}
+
+	err := newError(skip+1, builder.stack, nested, builder.message)
+	err.Severity = builder.severity
+
+	if len(builder.tags) > 0 {
+		err = err.WithTags(builder.tags...)
+	}
+
+	for _, attachment := range builder.attachments {
+		err = err.WithAttachment(attachment.Name, attachment.Data)
+	}
+
+	return err
+}
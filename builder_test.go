@@ -0,0 +1,33 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleBuild() {
+	err := Build("can't deploy").
+		Reason(errors.New("connection refused")).
+		Reason(nil).
+		Reason(Errorf(errors.New("disk is full"), "can't upload")).
+		Context("host", "example.com").
+		Code("ECONNREFUSED").
+		Err()
+
+	fmt.Println(err)
+	fmt.Println(Buildf("can't open %s", "config.toml").Err())
+
+	// Output:
+	// can't deploy
+	// ├─ connection refused
+	// │
+	// ├─ can't upload
+	// │  └─ disk is full
+	// │
+	// ├─ host
+	// │  └─ example.com
+	// │
+	// └─ code
+	//    └─ ECONNREFUSED
+	// can't open config.toml
+}
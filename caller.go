@@ -0,0 +1,55 @@
+package hierr
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+)
+
+// Caller represents location in source code, where error is created.
+type Caller struct {
+	// Function is a fully qualified name of function.
+	Function string
+
+	// File is a full path to the source file.
+	File string
+
+	// Line is a line number in the source file.
+	Line int
+}
+
+// String returns location in short form: package directory, file name and
+// line number.
+func (caller *Caller) String() string {
+	return fmt.Sprintf(
+		"%s/%s:%d",
+		filepath.Base(filepath.Dir(caller.File)),
+		filepath.Base(caller.File),
+		caller.Line,
+	)
+}
+
+// recordCaller returns location of the caller, skipping specified number
+// of frames beside caller of recordCaller, if RecordCaller is set or debug
+// mode is enabled.
+func recordCaller(skip int) *Caller {
+	if !GetConfig().RecordCaller && !IsDebug() {
+		return nil
+	}
+
+	pc, file, line, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return nil
+	}
+
+	caller := &Caller{
+		File: file,
+		Line: line,
+	}
+
+	if function := runtime.FuncForPC(pc); function != nil {
+		caller.Function = function.Name()
+	}
+
+	return caller
+}
@@ -0,0 +1,54 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+func ExampleRecordCaller() {
+	defer func() {
+		RecordCaller = false
+	}()
+
+	RecordCaller = true
+
+	err := Errorf(
+		Errorf(errors.New("exit status 128"), "can't pull remote"),
+		"can't update repository",
+	)
+
+	caller := err.(Error).Caller
+
+	// package directory depends on checkout location
+	directory := filepath.Base(filepath.Dir(caller.File))
+
+	fmt.Println(strings.Replace(err.Error(), "("+directory+"/", "(", -1))
+	fmt.Println(caller.Function)
+
+	// Output:
+	// can't update repository (caller_test.go:17)
+	// └─ can't pull remote (caller_test.go:18)
+	//    └─ exit status 128
+	// github.com/reconquest/hierr-go.ExampleRecordCaller
+}
+
+func wrapf(reason error, message string) error {
+	return ErrorfSkip(1, reason, "%s", message)
+}
+
+func ExampleErrorfSkip() {
+	defer func() {
+		RecordCaller = false
+	}()
+
+	RecordCaller = true
+
+	err := wrapf(errors.New("exit status 128"), "can't pull remote")
+
+	fmt.Println(err.(Error).Caller.Function)
+
+	// Output:
+	// github.com/reconquest/hierr-go.ExampleErrorfSkip
+}
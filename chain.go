@@ -0,0 +1,104 @@
+package hierr
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+// ParseChain converts colon-chained error message, which is produced by
+// errors, which are wrapped using fmt.Errorf("...: %w"), into hierarchy
+// error, as inverse of the package transformation:
+//
+//	can't pull remote 'origin': can't run git fetch 'origin' 'refs/tokens/*:refs/tokens/*': exit status 128
+//
+// Becomes:
+//
+//	can't pull remote 'origin'
+//	└─ can't run git fetch 'origin' 'refs/tokens/*:refs/tokens/*'
+//	   └─ exit status 128
+//
+// Message is split on ": " separators, which are not enclosed into quotes
+// or brackets, empty parts are skipped. Nil is returned for empty message.
+func ParseChain(message string) error {
+	parts := splitChain(message)
+	if len(parts) == 0 {
+		return nil
+	}
+
+	var err error = errors.New(parts[len(parts)-1])
+	for index := len(parts) - 2; index >= 0; index-- {
+		err = Error{Message: parts[index], Nested: err}
+	}
+
+	return err
+}
+
+func splitChain(message string) []string {
+	var (
+		parts  = []string{}
+		start  = 0
+		quote  = rune(0)
+		runes  = []rune(message)
+		closer = map[rune]rune{'(': ')', '[': ']', '{': '}'}
+		stack  = []rune{}
+	)
+
+	add := func(part string) {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	for index := 0; index < len(runes); index++ {
+		char := runes[index]
+
+		switch {
+		case quote != 0:
+			if char == '\\' {
+				index++
+			} else if char == quote {
+				quote = 0
+			}
+
+		case char == '\'' || char == '"' || char == '`':
+			if isQuote(runes, index) {
+				quote = char
+			}
+
+		case closer[char] != 0:
+			stack = append(stack, closer[char])
+
+		case len(stack) > 0 && char == stack[len(stack)-1]:
+			stack = stack[:len(stack)-1]
+
+		case len(stack) == 0 && char == ':' &&
+			index+1 < len(runes) && runes[index+1] == ' ':
+			add(string(runes[start:index]))
+			start = index + 2
+			index++
+		}
+	}
+
+	add(string(runes[start:]))
+
+	return parts
+}
+
+// isQuote returns true if quote at given index opens quoted text: it's not
+// preceded by letter or digit and has closing quote, so apostrophes, like in
+// "can't", are not considered as quotes.
+func isQuote(runes []rune, index int) bool {
+	if index > 0 &&
+		(unicode.IsLetter(runes[index-1]) || unicode.IsDigit(runes[index-1])) {
+		return false
+	}
+
+	for _, char := range runes[index+1:] {
+		if char == runes[index] {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,24 @@
+package hierr
+
+import (
+	"fmt"
+)
+
+func ExampleParseChain() {
+	fmt.Println(ParseChain(
+		"can't pull remote 'origin': " +
+			"can't run git fetch 'origin' 'refs/tokens/*:refs/tokens/*': " +
+			"exit status 128",
+	))
+
+	fmt.Println(ParseChain(`can't decode "a: b" [line 1: column 2]: unexpected EOF`))
+	fmt.Println(ParseChain(""))
+
+	// Output:
+	// can't pull remote 'origin'
+	// └─ can't run git fetch 'origin' 'refs/tokens/*:refs/tokens/*'
+	//    └─ exit status 128
+	// can't decode "a: b" [line 1: column 2]
+	// └─ unexpected EOF
+	// <nil>
+}
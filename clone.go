@@ -0,0 +1,37 @@
+package hierr
+
+// Clone returns deep copy of the error tree: nested lists and every nested
+// hierarchy error are copied, so copy can be modified without affecting
+// original error. Reasons, which are not hierr.Error, like standard errors
+// or context values, are not copied, since they're treated as immutable.
+func (err Error) Clone() Error {
+	clone := err
+
+	if err.Caller != nil {
+		caller := *err.Caller
+		clone.Caller = &caller
+	}
+
+	switch nested := err.Nested.(type) {
+	case []NestedError:
+		children := make([]NestedError, len(nested))
+		for index, child := range nested {
+			children[index] = cloneNode(child)
+		}
+
+		clone.Nested = children
+
+	default:
+		clone.Nested = cloneNode(nested)
+	}
+
+	return clone
+}
+
+func cloneNode(node NestedError) NestedError {
+	if err, ok := node.(Error); ok {
+		return err.Clone()
+	}
+
+	return node
+}
@@ -0,0 +1,31 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleError_Clone() {
+	original := Context(
+		Errorf(errors.New("connection refused"), "can't connect"),
+		Context("host", "example.com"),
+	).(Error)
+
+	clone := original.Clone()
+	clone.Nested.([]NestedError)[1].(Error).Nested.([]NestedError)[0] = "[redacted]"
+
+	fmt.Println(original)
+	fmt.Println(clone)
+
+	// Output:
+	// can't connect
+	// ├─ connection refused
+	// │
+	// └─ host
+	//    └─ example.com
+	// can't connect
+	// ├─ connection refused
+	// │
+	// └─ host
+	//    └─ [redacted]
+}
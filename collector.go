@@ -0,0 +1,80 @@
+package hierr
+
+import (
+	"sync"
+)
+
+// Collector collects errors of concurrent operations by their labels and
+// produces single error tree with a branch per label. Collector is safe for
+// concurrent use and its zero value is ready to use:
+//
+//	collector := hierr.Collector{}
+//	for _, host := range hosts {
+//		go func() {
+//			collector.Add(host, deploy(host))
+//		}()
+//	}
+//	...
+//	return collector.Error("deployment failed")
+type Collector struct {
+	mutex  sync.Mutex
+	labels []string
+	errors map[string][]NestedError
+}
+
+// Add adds error under specified label, nil errors are ignored. Errors,
+// which are added under the same label, are reported in the same branch.
+func (collector *Collector) Add(label string, err error) {
+	if err == nil {
+		return
+	}
+
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+
+	if collector.errors == nil {
+		collector.errors = map[string][]NestedError{}
+	}
+
+	if _, ok := collector.errors[label]; !ok {
+		collector.labels = append(collector.labels, label)
+	}
+
+	collector.errors[label] = append(collector.errors[label], err)
+}
+
+// Len returns number of labels, which have errors.
+func (collector *Collector) Len() int {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+
+	return len(collector.labels)
+}
+
+// Error returns hierarchy error with given message, which reasons are
+// labels in order of their first addition, with collected errors nested
+// into them. Nil is returned if no errors were collected.
+func (collector *Collector) Error(message string) error {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+
+	if len(collector.labels) == 0 {
+		return nil
+	}
+
+	branches := []NestedError{}
+	for _, label := range collector.labels {
+		var nested NestedError
+
+		errs := collector.errors[label]
+		if len(errs) == 1 {
+			nested = errs[0]
+		} else {
+			nested = append([]NestedError{}, errs...)
+		}
+
+		branches = append(branches, Error{Message: label, Nested: nested})
+	}
+
+	return Push(message, branches...)
+}
@@ -0,0 +1,56 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+func ExampleCollector() {
+	collector := Collector{}
+
+	fmt.Println(collector.Error("deployment failed"))
+
+	group := sync.WaitGroup{}
+	for _, host := range []string{"node-a", "node-b", "node-c"} {
+		group.Add(1)
+
+		go func() {
+			defer group.Done()
+
+			if host != "node-b" {
+				collector.Add(host, errors.New("connection refused"))
+			}
+		}()
+	}
+
+	group.Wait()
+
+	collector.Add("node-d", nil)
+
+	fmt.Println(collector.Len())
+	fmt.Println(len(collector.Error("deployment failed").(Error).Leaves()))
+
+	// Output:
+	// <nil>
+	// 2
+	// 2
+}
+
+func ExampleCollector_Error() {
+	collector := Collector{}
+	collector.Add("node-a", errors.New("connection refused"))
+	collector.Add("node-b", errors.New("disk is full"))
+	collector.Add("node-b", errors.New("permission denied"))
+
+	fmt.Println(collector.Error("deployment failed"))
+
+	// Output:
+	// deployment failed
+	// ├─ node-a
+	// │  └─ connection refused
+	// │
+	// └─ node-b
+	//    ├─ disk is full
+	//    └─ permission denied
+}
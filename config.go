@@ -0,0 +1,120 @@
+package hierr
+
+import (
+	"sync/atomic"
+)
+
+// Config represents settings of creating and rendering errors, which are
+// otherwise set by package variables.
+//
+// Settings are taken from the last Configure() call or, if Configure() was
+// never called, from package variables. Package variables are read without
+// synchronization, so they may be changed only before errors are created or
+// rendered concurrently, for example in init() or main(). Settings, which
+// are changed at runtime, must be set via Configure(), after which package
+// variables are ignored.
+type Config struct {
+	// BranchDelimiter, BranchChainer, BranchSplitter and BranchIndent have
+	// the same meaning as package variables with the same names.
+	BranchDelimiter string
+	BranchChainer   string
+	BranchSplitter  string
+	BranchIndent    int
+
+	// RecordCaller, RecordGoroutine, RecordID, RecordTime and StackDepth have
+	// the same meaning as package variables with the same names.
+	RecordCaller    bool
+	RecordGoroutine bool
+	RecordID        bool
+	RecordTime      bool
+	StackDepth      int
+
+	// PlainErrors has the same meaning as package variable with the same
+	// name.
+	PlainErrors bool
+
+	// Hyperlinks and HyperlinkTemplate have the same meaning as package
+	// variables with the same names.
+	Hyperlinks        bool
+	HyperlinkTemplate string
+
+	// Deduplicate and DuplicateCounter have the same meaning as package
+	// variables with the same names.
+	Deduplicate      bool
+	DuplicateCounter string
+
+	// FlatText has the same meaning as package variable with the same name.
+	FlatText bool
+
+	// ReportCreated has the same meaning as package variable with the same
+	// name.
+	ReportCreated bool
+
+	// Colorize has the same meaning as package variable with the same name.
+	Colorize bool
+
+	// ExplainErrno has the same meaning as package variable with the same
+	// name.
+	ExplainErrno bool
+
+	// MaxAttachmentSize has the same meaning as package variable with the
+	// same name.
+	MaxAttachmentSize int
+
+	// HintPrefix and AttachmentPrefix have the same meaning as package
+	// variables with the same names.
+	HintPrefix       string
+	AttachmentPrefix string
+}
+
+var config atomic.Pointer[Config]
+
+// Configure atomically replaces settings of creating and rendering errors.
+// Once Configure is called, package variables like BranchIndent are no
+// longer used and changing them has no effect, so settings can be changed
+// while other goroutines render errors without data races. Every error is
+// rendered using single snapshot of settings.
+//
+// Use: hierr.Configure(hierr.GetConfig()) to take settings from package
+// variables and then modify them only via Configure().
+func Configure(settings Config) {
+	config.Store(&settings)
+}
+
+// GetConfig returns current settings, which are either set by Configure()
+// or by package variables, if Configure() was never called. Settings set by
+// Configure() take precedence over package variables.
+func GetConfig() Config {
+	if settings := config.Load(); settings != nil {
+		return *settings
+	}
+
+	return Config{
+		BranchDelimiter:   BranchDelimiter,
+		BranchChainer:     BranchChainer,
+		BranchSplitter:    BranchSplitter,
+		BranchIndent:      BranchIndent,
+		RecordCaller:      RecordCaller,
+		RecordGoroutine:   RecordGoroutine,
+		RecordID:          RecordID,
+		RecordTime:        RecordTime,
+		StackDepth:        StackDepth,
+		PlainErrors:       PlainErrors,
+		Hyperlinks:        Hyperlinks,
+		HyperlinkTemplate: HyperlinkTemplate,
+		Deduplicate:       Deduplicate,
+		DuplicateCounter:  DuplicateCounter,
+		FlatText:          FlatText,
+		ReportCreated:     ReportCreated,
+		Colorize:          Colorize,
+		ExplainErrno:      ExplainErrno,
+		MaxAttachmentSize: MaxAttachmentSize,
+		HintPrefix:        HintPrefix,
+		AttachmentPrefix:  AttachmentPrefix,
+	}
+}
+
+// resetConfig returns to settings, which are set by package variables.
+func resetConfig() {
+	config.Store(nil)
+}
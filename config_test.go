@@ -0,0 +1,85 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+)
+
+func ExampleConfigure() {
+	defer resetConfig()
+
+	settings := GetConfig()
+	settings.BranchDelimiter = BranchDelimiterASCII
+	settings.BranchIndent = 2
+
+	Configure(settings)
+
+	shared := Errorf(Errorf(errors.New("third"), "second"), "top level")
+
+	group := sync.WaitGroup{}
+	for index := 0; index < 4; index++ {
+		group.Add(2)
+
+		go func() {
+			defer group.Done()
+
+			_ = shared.Error()
+		}()
+
+		go func() {
+			defer group.Done()
+
+			Configure(settings)
+		}()
+	}
+
+	group.Wait()
+
+	fmt.Println(shared)
+
+	// Output:
+	// top level
+	// \_ second
+	//   \_ third
+}
+
+func ExampleConfig_prefixes() {
+	defer resetConfig()
+
+	settings := GetConfig()
+	settings.HintPrefix = "try: "
+	settings.AttachmentPrefix = "file: "
+	settings.Hyperlinks = true
+
+	Configure(settings)
+
+	HintPrefix = "ignored: "
+	defer func() {
+		HintPrefix = "hint: "
+	}()
+
+	file, err := ioutil.TempFile(os.TempDir(), "output")
+	if err != nil {
+		panic(err)
+	}
+
+	defer os.Remove(file.Name())
+
+	fmt.Println(EnableHyperlinks(file), GetConfig().Hyperlinks)
+
+	fmt.Println(
+		Errorf(errors.New("disk is full"), "can't write").(Error).
+			WithHint("remove old logs").
+			WithAttachment("df", []byte("100%")),
+	)
+
+	// Output:
+	// false false
+	// can't write
+	// ├─ disk is full
+	// ├─ file: df, 4B
+	// └─ try: remove old logs
+}
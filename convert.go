@@ -0,0 +1,78 @@
+package hierr
+
+import (
+	"strings"
+)
+
+// FromError converts chain of wrapped errors into equivalent hierarchy
+// error, so errors, which are wrapped by fmt.Errorf() with %w verb, are
+// displayed as trees:
+//
+//	can't load config: open config.toml: no such file or directory
+//
+// Becomes:
+//
+//	can't load config
+//	└─ open config.toml
+//	   └─ no such file or directory
+//
+// Error, which message ends with message of wrapped error, becomes node with
+// message prefix, error, which message equals to message of wrapped error,
+// is skipped, and any other wrapping error becomes node with own message.
+// Errors, which wrap multiple errors, become nodes with every wrapped error
+// as reason, errors of errors.Join() get MergeMessage message. Terminal
+// errors and hierarchy errors are kept as is, so they still can be matched
+// by errors.Is() and errors.As(). Nil is returned for nil error.
+func FromError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := err.(HierarchicalError); ok {
+		return err
+	}
+
+	if decomposed, ok := decompose(err); ok {
+		return decomposed
+	}
+
+	message := err.Error()
+
+	switch wrapper := err.(type) {
+	case interface{ Unwrap() []error }:
+		errs := wrapper.Unwrap()
+
+		reasons := []NestedError{}
+		for _, nested := range errs {
+			if nested != nil {
+				reasons = append(reasons, FromError(nested))
+			}
+		}
+
+		if isJoined(err, errs) {
+			message = MergeMessage
+		}
+
+		return Error{Message: message, Nested: reasons}
+
+	case interface{ Unwrap() error }:
+		inner := wrapper.Unwrap()
+		if inner == nil {
+			return err
+		}
+
+		innerMessage := inner.Error()
+
+		switch {
+		case message == innerMessage:
+			return FromError(inner)
+
+		case strings.HasSuffix(message, ": "+innerMessage):
+			message = strings.TrimSuffix(message, ": "+innerMessage)
+		}
+
+		return Error{Message: message, Nested: FromError(inner)}
+	}
+
+	return err
+}
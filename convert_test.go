@@ -0,0 +1,40 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+)
+
+func ExampleFromError() {
+	_, err := os.Open("/nonexistent/config.toml")
+
+	converted := FromError(fmt.Errorf("can't load config: %w", err))
+
+	fmt.Println(converted)
+	fmt.Println(AnyIs(converted, fs.ErrNotExist))
+
+	fmt.Println(FromError(fmt.Errorf(
+		"can't deploy: %w",
+		errors.Join(
+			errors.New("connection refused"),
+			fmt.Errorf("%w (after 3 attempts)", errors.New("timeout")),
+		),
+	)))
+
+	fmt.Println(FromError(nil))
+
+	// Output:
+	// can't load config
+	// └─ open /nonexistent/config.toml
+	//    └─ no such file or directory
+	// true
+	// can't deploy
+	// └─ multiple errors occurred
+	//    ├─ connection refused
+	//    │
+	//    └─ timeout (after 3 attempts)
+	//       └─ timeout
+	// <nil>
+}
@@ -0,0 +1,72 @@
+package hierr
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// OperationKey is a key of context pair, which contains name of
+	// operation, that didn't complete in time, as reported by WithDeadline().
+	OperationKey = "operation"
+
+	// TimeoutKey is a key of context pair, which contains timeout of
+	// operation, as reported by WithDeadline().
+	TimeoutKey = "timeout"
+
+	// ElapsedKey is a key of context pair, which contains time, elapsed since
+	// operation was started, as reported by WithDeadline().
+	ElapsedKey = "elapsed"
+)
+
+// WithDeadline runs given function with context, which is canceled after
+// specified timeout. If timeout is not positive, deadline of parent context
+// is used.
+//
+// When function fails with context.DeadlineExceeded, which can be located
+// anywhere in the error tree, as reported by AnyIs(), error is wrapped into
+// hierarchy error with name of operation, timeout and elapsed time as
+// context pairs:
+//
+//	operation timed out
+//	├─ context deadline exceeded
+//	│
+//	├─ operation
+//	│  └─ fetch origin
+//	│
+//	├─ timeout
+//	│  └─ 5s
+//	│
+//	└─ elapsed
+//	   └─ 5.001s
+//
+// Other errors are returned as is.
+func WithDeadline(
+	ctx context.Context,
+	operation string,
+	timeout time.Duration,
+	fn func(ctx context.Context) error,
+) error {
+	started := time.Now()
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	} else if deadline, ok := ctx.Deadline(); ok {
+		timeout = deadline.Sub(started)
+	}
+
+	err := fn(ctx)
+	if err == nil || !AnyIs(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	return newError(1, false, []NestedError{
+		err,
+		Context(OperationKey, operation),
+		Context(TimeoutKey, timeout),
+		Context(ElapsedKey, time.Since(started)),
+	}, "operation timed out")
+}
@@ -0,0 +1,46 @@
+package hierr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+func ExampleWithDeadline() {
+	fetch := func(ctx context.Context) error {
+		<-ctx.Done()
+
+		return Errorf(ctx.Err(), "can't fetch origin")
+	}
+
+	err := WithDeadline(context.Background(), "fetch origin", time.Millisecond, fetch)
+
+	fmt.Println(EqualIgnoringContext(err, Push(
+		"operation timed out",
+		Errorf(context.DeadlineExceeded, "can't fetch origin"),
+	)))
+
+	value, _ := err.(Error).GetValue(OperationKey)
+	fmt.Println(value)
+
+	value, _ = err.(Error).GetValue(TimeoutKey)
+	fmt.Println(value)
+
+	value, _ = err.(Error).GetValue(ElapsedKey)
+	fmt.Println(value.(time.Duration) >= time.Millisecond)
+
+	fmt.Println(WithDeadline(
+		context.Background(), "fetch origin", time.Second,
+		func(context.Context) error {
+			return errors.New("connection refused")
+		},
+	))
+
+	// Output:
+	// true
+	// fetch origin
+	// 1ms
+	// true
+	// connection refused
+}
@@ -0,0 +1,43 @@
+package hierr
+
+import (
+	"os"
+	"strconv"
+	"sync/atomic"
+)
+
+// DebugEnvironmentVariable is a name of environment variable, which enables
+// debug mode on program start, if it's set to true value, like "1".
+const DebugEnvironmentVariable = "HIERR_DEBUG"
+
+var debug atomic.Bool
+
+func init() {
+	enabled, _ := strconv.ParseBool(os.Getenv(DebugEnvironmentVariable))
+	debug.Store(enabled)
+}
+
+// SetDebug enables or disables debug mode. In debug mode every created error
+// records caller and captures call stack, and Error() returns verbose
+// representation of error, which includes call stacks, as %+v does.
+//
+// Debug mode can also be enabled by setting HIERR_DEBUG=1 environment
+// variable.
+func SetDebug(enabled bool) {
+	debug.Store(enabled)
+}
+
+// IsDebug returns true if debug mode is enabled.
+func IsDebug() bool {
+	return debug.Load()
+}
+
+// debugStack captures call stack, skipping specified number of frames beside
+// caller of debugStack, if debug mode is enabled.
+func debugStack(skip int) *Stack {
+	if !IsDebug() {
+		return nil
+	}
+
+	return captureStack(skip + 1)
+}
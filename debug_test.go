@@ -0,0 +1,42 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+func ExampleSetDebug() {
+	defer SetDebug(false)
+
+	SetDebug(true)
+
+	err := Errorf(errors.New("exit status 128"), "can't pull remote").(Error)
+
+	lines := strings.Split(err.Error(), "\n")
+
+	fmt.Println(IsDebug())
+	fmt.Println(err.Caller != nil, err.Stack != nil)
+	fmt.Println(strings.HasPrefix(lines[0], "can't pull remote ("))
+	fmt.Println(strings.HasPrefix(
+		lines[1], "  at github.com/reconquest/hierr-go.ExampleSetDebug (",
+	))
+	fmt.Println(lines[len(lines)-1])
+
+	SetDebug(false)
+
+	err = Errorf(errors.New("exit status 128"), "can't pull remote").(Error)
+
+	fmt.Println(err.Caller != nil, err.Stack != nil)
+	fmt.Println(err)
+
+	// Output:
+	// true
+	// true true
+	// true
+	// true
+	// └─ exit status 128
+	// false false
+	// can't pull remote
+	// └─ exit status 128
+}
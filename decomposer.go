@@ -0,0 +1,113 @@
+package hierr
+
+import (
+	"sync"
+)
+
+// Decomposer explodes errors of third-party types into message, reasons and
+// context fields, so they are displayed and traversed as hierarchy errors.
+// Decomposer returns false if given error is not supported.
+type Decomposer func(err error) (
+	message string,
+	reasons []NestedError,
+	fields []Field,
+	ok bool,
+)
+
+var decomposers struct {
+	sync.RWMutex
+
+	list []Decomposer
+}
+
+// RegisterDecomposer registers decomposer, which will be used by Error(),
+// Decompose() and FromError() for errors, which are not hierarchy errors.
+// Decomposers are tried in order of their registration and the first one,
+// which supports error, is used:
+//
+//	hierr.RegisterDecomposer(func(err error) (
+//		string, []hierr.NestedError, []hierr.Field, bool,
+//	) {
+//		pathErr, ok := err.(*os.PathError)
+//		if !ok {
+//			return "", nil, nil, false
+//		}
+//
+//		return "can't " + pathErr.Op + " file",
+//			[]hierr.NestedError{pathErr.Err},
+//			[]hierr.Field{{Key: "path", Value: pathErr.Path}},
+//			true
+//	})
+func RegisterDecomposer(decomposer Decomposer) {
+	decomposers.Lock()
+	defer decomposers.Unlock()
+
+	decomposers.list = append(decomposers.list, decomposer)
+}
+
+// decompose returns hierarchy error, which is built by the first registered
+// decomposer, which supports given node.
+func decompose(node NestedError) (Error, bool) {
+	return decomposeNode(node, GetConfig().ExplainErrno)
+}
+
+// decomposeNode decomposes given node as decompose() does, using errno
+// decomposer if errno is set, so settings can be read once per rendering.
+func decomposeNode(node NestedError, errno bool) (Error, bool) {
+	err, ok := node.(error)
+	if !ok {
+		return Error{}, false
+	}
+
+	if _, ok := node.(HierarchicalError); ok {
+		return Error{}, false
+	}
+
+	decomposers.RLock()
+	list := decomposers.list
+	decomposers.RUnlock()
+
+	for _, decomposer := range list {
+		message, reasons, fields, ok := decomposer(err)
+		if ok {
+			return decomposedError(message, reasons, fields), true
+		}
+	}
+
+	if errno {
+		message, reasons, fields, ok := decomposeErrno(err)
+		if ok {
+			return decomposedError(message, reasons, fields), true
+		}
+	}
+
+	return Error{}, false
+}
+
+// decomposedError returns hierarchy error, which is built from results of
+// decomposer.
+func decomposedError(
+	message string,
+	reasons []NestedError,
+	fields []Field,
+) Error {
+	nested := append([]NestedError{}, reasons...)
+	for _, field := range fields {
+		nested = append(nested, Context(field.Key, field.Value))
+	}
+
+	decomposed := Error{Message: message}
+	if len(nested) > 0 {
+		decomposed.Nested = nested
+	}
+
+	return decomposed
+}
+
+// resetDecomposers unregisters all decomposers.
+func resetDecomposers() {
+	decomposers.Lock()
+	defer decomposers.Unlock()
+
+	decomposers.list = nil
+}
@@ -0,0 +1,46 @@
+package hierr
+
+import (
+	"fmt"
+	"os"
+)
+
+func ExampleRegisterDecomposer() {
+	defer resetDecomposers()
+
+	RegisterDecomposer(func(err error) (string, []NestedError, []Field, bool) {
+		pathErr, ok := err.(*os.PathError)
+		if !ok {
+			return "", nil, nil, false
+		}
+
+		return "can't " + pathErr.Op + " file",
+			[]NestedError{pathErr.Err},
+			[]Field{{Key: "path", Value: pathErr.Path}},
+			true
+	})
+
+	_, err := os.Open("/nonexistent/config.toml")
+
+	fmt.Println(Errorf(err, "can't load config"))
+
+	message, fields, reasons := Decompose(err)
+	fmt.Println(message, fields, reasons)
+
+	fmt.Println(FromError(fmt.Errorf("can't load config: %w", err)))
+
+	// Output:
+	// can't load config
+	// └─ can't open file
+	//    ├─ no such file or directory
+	//    │
+	//    └─ path
+	//       └─ /nonexistent/config.toml
+	// can't open file [{path /nonexistent/config.toml}] [no such file or directory]
+	// can't load config
+	// └─ can't open file
+	//    ├─ no such file or directory
+	//    │
+	//    └─ path
+	//       └─ /nonexistent/config.toml
+}
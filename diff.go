@@ -0,0 +1,250 @@
+package hierr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ChangeKind represents kind of difference between two error trees.
+type ChangeKind int
+
+const (
+	// ChangeAdded means that node or context pair is present only in the
+	// second tree.
+	ChangeAdded ChangeKind = iota
+
+	// ChangeRemoved means that node or context pair is present only in the
+	// first tree.
+	ChangeRemoved
+
+	// ChangeModified means that message of node or value of context pair is
+	// different.
+	ChangeModified
+)
+
+// Change represents single difference between two error trees.
+type Change struct {
+	// Kind is a kind of change.
+	Kind ChangeKind
+
+	// Path is a chain of messages from the root to the changed node. For
+	// modified messages new message is used.
+	Path []string
+
+	// Key is a key of changed context pair, empty if node is changed.
+	Key string
+
+	// Old is old message or value, empty for added nodes and pairs.
+	Old string
+
+	// New is new message or value, empty for removed nodes and pairs.
+	New string
+}
+
+// Difference represents structured difference between two error trees.
+type Difference struct {
+	// Changes are differences in order of descending into the tree.
+	Changes []Change
+}
+
+// Diff returns structured difference between two error trees. Reasons of
+// nodes are matched by their messages, so reordering of reasons is not
+// considered as change. Nil errors are considered as empty trees.
+func Diff(a, b error) Difference {
+	difference := Difference{}
+
+	switch {
+	case a == nil && b == nil:
+
+	case a == nil:
+		difference.add(ChangeAdded, nil, b)
+
+	case b == nil:
+		difference.add(ChangeRemoved, nil, a)
+
+	default:
+		difference.compare(nil, a, b)
+	}
+
+	return difference
+}
+
+// Empty returns true if there are no changes.
+func (difference Difference) Empty() bool {
+	return len(difference.Changes) == 0
+}
+
+// String returns rendered difference, one change per line, where added
+// changes are prefixed with "+", removed ones with "-" and modified ones
+// with "~".
+func (difference Difference) String() string {
+	lines := []string{}
+	for _, change := range difference.Changes {
+		lines = append(lines, change.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// String returns rendered change.
+func (change Change) String() string {
+	path := strings.Join(change.Path, " > ")
+
+	switch {
+	case change.Kind == ChangeAdded && change.Key != "":
+		return fmt.Sprintf("+ %s [%s: %s]", path, change.Key, change.New)
+
+	case change.Kind == ChangeAdded:
+		return "+ " + path
+
+	case change.Kind == ChangeRemoved && change.Key != "":
+		return fmt.Sprintf("- %s [%s: %s]", path, change.Key, change.Old)
+
+	case change.Kind == ChangeRemoved:
+		return "- " + path
+
+	case change.Key != "":
+		return fmt.Sprintf(
+			"~ %s [%s: %s -> %s]", path, change.Key, change.Old, change.New,
+		)
+
+	default:
+		return fmt.Sprintf("~ %s [%q -> %q]", path, change.Old, change.New)
+	}
+}
+
+func (difference *Difference) add(
+	kind ChangeKind,
+	parent []string,
+	node NestedError,
+) {
+	message, _, _ := Decompose(node)
+
+	change := Change{
+		Kind: kind,
+		Path: appendPath(parent, message),
+	}
+
+	if kind == ChangeAdded {
+		change.New = message
+	} else {
+		change.Old = message
+	}
+
+	difference.Changes = append(difference.Changes, change)
+}
+
+func (difference *Difference) compare(parent []string, a, b NestedError) {
+	var (
+		oldMessage, oldFields, oldReasons = Decompose(a)
+		newMessage, newFields, newReasons = Decompose(b)
+	)
+
+	path := appendPath(parent, newMessage)
+
+	if oldMessage != newMessage {
+		difference.Changes = append(difference.Changes, Change{
+			Kind: ChangeModified,
+			Path: path,
+			Old:  oldMessage,
+			New:  newMessage,
+		})
+	}
+
+	difference.compareFields(path, oldFields, newFields)
+
+	matched := make([]bool, len(newReasons))
+
+	for _, oldReason := range oldReasons {
+		message, _, _ := Decompose(oldReason)
+
+		found := false
+		for index, newReason := range newReasons {
+			if matched[index] {
+				continue
+			}
+
+			if candidate, _, _ := Decompose(newReason); candidate == message {
+				matched[index] = true
+				found = true
+
+				difference.compare(path, oldReason, newReason)
+
+				break
+			}
+		}
+
+		if !found {
+			difference.add(ChangeRemoved, path, oldReason)
+		}
+	}
+
+	for index, newReason := range newReasons {
+		if !matched[index] {
+			difference.add(ChangeAdded, path, newReason)
+		}
+	}
+}
+
+func (difference *Difference) compareFields(
+	path []string,
+	oldFields []Field,
+	newFields []Field,
+) {
+	values := map[string]string{}
+	for _, field := range newFields {
+		if _, ok := values[field.Key]; !ok {
+			values[field.Key] = String(field.Value)
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, field := range oldFields {
+		if seen[field.Key] {
+			continue
+		}
+
+		seen[field.Key] = true
+
+		value := String(field.Value)
+
+		newValue, ok := values[field.Key]
+		switch {
+		case !ok:
+			difference.Changes = append(difference.Changes, Change{
+				Kind: ChangeRemoved,
+				Path: path,
+				Key:  field.Key,
+				Old:  value,
+			})
+
+		case newValue != value:
+			difference.Changes = append(difference.Changes, Change{
+				Kind: ChangeModified,
+				Path: path,
+				Key:  field.Key,
+				Old:  value,
+				New:  newValue,
+			})
+		}
+	}
+
+	for _, field := range newFields {
+		if seen[field.Key] {
+			continue
+		}
+
+		seen[field.Key] = true
+
+		difference.Changes = append(difference.Changes, Change{
+			Kind: ChangeAdded,
+			Path: path,
+			Key:  field.Key,
+			New:  String(field.Value),
+		})
+	}
+}
+
+func appendPath(parent []string, message string) []string {
+	return append(append([]string{}, parent...), message)
+}
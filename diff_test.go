@@ -0,0 +1,49 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleDiff() {
+	before := Context(
+		Push(
+			"can't deploy",
+			Context(
+				Errorf(errors.New("connection refused"), "can't connect"),
+				Context("host", "node-a"),
+				Context("port", "22"),
+			),
+			"timeout",
+		),
+		Context("release", "1.0"),
+	)
+
+	after := Context(
+		Push(
+			"can't deploy",
+			errors.New("disk is full"),
+			Context(
+				Errorf(errors.New("connection reset"), "can't connect"),
+				Context("host", "node-b"),
+				Context("user", "deploy"),
+			),
+		),
+		Context("release", "1.0"),
+	)
+
+	difference := Diff(before, after)
+
+	fmt.Println(difference)
+	fmt.Println(difference.Empty(), Diff(before, before).Empty())
+
+	// Output:
+	// ~ can't deploy > can't connect [host: node-a -> node-b]
+	// - can't deploy > can't connect [port: 22]
+	// + can't deploy > can't connect [user: deploy]
+	// - can't deploy > can't connect > connection refused
+	// + can't deploy > can't connect > connection reset
+	// - can't deploy > timeout
+	// + can't deploy > disk is full
+	// false true
+}
@@ -0,0 +1,39 @@
+package hierr
+
+import (
+	"time"
+)
+
+// Duration returns context pair with given key and duration, which is
+// rendered in humanized form, like 1.235s, as every time.Duration value is,
+// so latency information in context of errors is formatted consistently:
+//
+//	hierr.Push(err, hierr.Duration("elapsed", time.Since(started)))
+func Duration(key string, duration time.Duration) error {
+	return Context(key, duration)
+}
+
+// formatDuration returns duration, rounded to three fractional digits of the
+// largest unit, like 1.235s or 12.346ms.
+func formatDuration(duration time.Duration) string {
+	magnitude := duration
+	if magnitude < 0 {
+		magnitude = -magnitude
+	}
+
+	switch {
+	case magnitude >= time.Second:
+		duration = duration.Round(time.Millisecond)
+
+	case magnitude >= time.Millisecond:
+		duration = duration.Round(time.Microsecond)
+	}
+
+	return duration.String()
+}
+
+// formatTime returns time in RFC 3339 format with fractional seconds, if
+// they're set.
+func formatTime(moment time.Time) string {
+	return moment.Format(time.RFC3339Nano)
+}
@@ -0,0 +1,32 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+func ExampleDuration() {
+	err := Push(
+		"can't query users",
+		errors.New("connection reset by peer"),
+		Duration("elapsed", 1234567891*time.Nanosecond),
+		Context("latency", 12345678*time.Nanosecond),
+		Context("started", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+	)
+
+	fmt.Println(err)
+
+	// Output:
+	// can't query users
+	// ├─ connection reset by peer
+	// │
+	// ├─ elapsed
+	// │  └─ 1.235s
+	// │
+	// ├─ latency
+	// │  └─ 12.346ms
+	// │
+	// └─ started
+	//    └─ 2024-01-02T03:04:05Z
+}
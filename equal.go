@@ -0,0 +1,67 @@
+package hierr
+
+// Equal reports whether two error trees have the same messages, structure
+// and context. Reasons are compared in order, while context pairs of every
+// node are compared regardless of their order. Values of context pairs are
+// compared by their string representation.
+//
+// Unlike comparison of rendered errors, result of Equal does not depend on
+// formatting settings like BranchDelimiter or BranchIndent.
+func Equal(a, b error) bool {
+	return equal(a, b, true)
+}
+
+// EqualIgnoringContext reports whether two error trees have the same
+// messages and structure as Equal does, but ignores context pairs.
+func EqualIgnoringContext(a, b error) bool {
+	return equal(a, b, false)
+}
+
+func equal(a, b NestedError, context bool) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	var (
+		oldMessage, oldFields, oldReasons = Decompose(a)
+		newMessage, newFields, newReasons = Decompose(b)
+	)
+
+	if oldMessage != newMessage || len(oldReasons) != len(newReasons) {
+		return false
+	}
+
+	if context && !equalFields(oldFields, newFields) {
+		return false
+	}
+
+	for index := range oldReasons {
+		if !equal(oldReasons[index], newReasons[index], context) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalFields(a, b []Field) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := map[Field]int{}
+	for _, field := range a {
+		counts[Field{Key: field.Key, Value: String(field.Value)}]++
+	}
+
+	for _, field := range b {
+		key := Field{Key: field.Key, Value: String(field.Value)}
+		if counts[key] == 0 {
+			return false
+		}
+
+		counts[key]--
+	}
+
+	return true
+}
@@ -0,0 +1,35 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleEqual() {
+	tree := func(host string, port int) error {
+		return Context(
+			Errorf(errors.New("connection refused"), "can't connect"),
+			Context("host", host),
+			Context("port", port),
+		)
+	}
+
+	reordered := Context(
+		Errorf(errors.New("connection refused"), "can't connect"),
+		Context("port", "22"),
+		Context("host", "example.com"),
+	)
+
+	fmt.Println(Equal(tree("example.com", 22), reordered))
+	fmt.Println(Equal(tree("example.com", 22), tree("example.org", 22)))
+	fmt.Println(EqualIgnoringContext(tree("example.com", 22), tree("example.org", 22)))
+	fmt.Println(EqualIgnoringContext(tree("example.com", 22), errors.New("can't connect")))
+	fmt.Println(Equal(nil, nil))
+
+	// Output:
+	// true
+	// false
+	// true
+	// false
+	// true
+}
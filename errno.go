@@ -0,0 +1,98 @@
+package hierr
+
+import (
+	"os"
+	"syscall"
+)
+
+const (
+	// ErrnoKey is a key of context pair, which contains number of
+	// syscall.Errno, which is added if ExplainErrno is set.
+	ErrnoKey = "errno"
+
+	// ErrnoNameKey is a key of context pair, which contains symbolic name of
+	// syscall.Errno, like ECONNREFUSED, which is added if ExplainErrno is
+	// set and name is known.
+	ErrnoNameKey = "errno name"
+)
+
+// ExplainErrno set whether syscall.Errno, *os.PathError, *os.SyscallError
+// and *os.LinkError reasons should be decomposed, so low-level failures are
+// self-explanatory:
+//
+//	open /etc/app.conf
+//	└─ no such file or directory
+//	   ├─ errno
+//	   │  └─ 2
+//	   │
+//	   └─ errno name
+//	      └─ ENOENT
+//
+// Errno becomes node with error text as message and its number and name as
+// context pairs, errors of os package become nodes with operation and path
+// as message and wrapped error as reason. Decomposers, which are registered
+// by RegisterDecomposer(), take precedence.
+var ExplainErrno = false
+
+var errnoNames = map[syscall.Errno]string{
+	syscall.E2BIG:         "E2BIG",
+	syscall.EACCES:        "EACCES",
+	syscall.EADDRINUSE:    "EADDRINUSE",
+	syscall.EADDRNOTAVAIL: "EADDRNOTAVAIL",
+	syscall.EAGAIN:        "EAGAIN",
+	syscall.EBADF:         "EBADF",
+	syscall.EBUSY:         "EBUSY",
+	syscall.ECHILD:        "ECHILD",
+	syscall.ECONNABORTED:  "ECONNABORTED",
+	syscall.ECONNREFUSED:  "ECONNREFUSED",
+	syscall.ECONNRESET:    "ECONNRESET",
+	syscall.EEXIST:        "EEXIST",
+	syscall.EHOSTUNREACH:  "EHOSTUNREACH",
+	syscall.EINTR:         "EINTR",
+	syscall.EINVAL:        "EINVAL",
+	syscall.EIO:           "EIO",
+	syscall.EISDIR:        "EISDIR",
+	syscall.ELOOP:         "ELOOP",
+	syscall.EMFILE:        "EMFILE",
+	syscall.ENAMETOOLONG:  "ENAMETOOLONG",
+	syscall.ENETUNREACH:   "ENETUNREACH",
+	syscall.ENFILE:        "ENFILE",
+	syscall.ENOENT:        "ENOENT",
+	syscall.ENOMEM:        "ENOMEM",
+	syscall.ENOSPC:        "ENOSPC",
+	syscall.ENOSYS:        "ENOSYS",
+	syscall.ENOTCONN:      "ENOTCONN",
+	syscall.ENOTDIR:       "ENOTDIR",
+	syscall.ENOTEMPTY:     "ENOTEMPTY",
+	syscall.EPERM:         "EPERM",
+	syscall.EPIPE:         "EPIPE",
+	syscall.EROFS:         "EROFS",
+	syscall.ESRCH:         "ESRCH",
+	syscall.ETIMEDOUT:     "ETIMEDOUT",
+	syscall.EXDEV:         "EXDEV",
+}
+
+// decomposeErrno is a decomposer of syscall.Errno and errors of os package.
+func decomposeErrno(err error) (string, []NestedError, []Field, bool) {
+	switch err := err.(type) {
+	case *os.PathError:
+		return err.Op + " " + err.Path, []NestedError{err.Err}, nil, true
+
+	case *os.SyscallError:
+		return err.Syscall, []NestedError{err.Err}, nil, true
+
+	case *os.LinkError:
+		return err.Op + " " + err.Old + " " + err.New,
+			[]NestedError{err.Err}, nil, true
+
+	case syscall.Errno:
+		fields := []Field{{Key: ErrnoKey, Value: int(err)}}
+		if name, ok := errnoNames[err]; ok {
+			fields = append(fields, Field{Key: ErrnoNameKey, Value: name})
+		}
+
+		return err.Error(), nil, fields, true
+	}
+
+	return "", nil, nil, false
+}
@@ -0,0 +1,39 @@
+package hierr
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+func ExampleExplainErrno() {
+	ExplainErrno = true
+	defer func() {
+		ExplainErrno = false
+	}()
+
+	_, err := os.Open("/nonexistent/app.conf")
+
+	fmt.Println(Errorf(err, "can't load config"))
+	fmt.Println(AnyIs(Errorf(err, "can't load config"), os.ErrNotExist))
+	fmt.Println(Push("can't listen", os.NewSyscallError("bind", syscall.EACCES)))
+
+	// Output:
+	// can't load config
+	// └─ open /nonexistent/app.conf
+	//    └─ no such file or directory
+	//       ├─ errno
+	//       │  └─ 2
+	//       │
+	//       └─ errno name
+	//          └─ ENOENT
+	// true
+	// can't listen
+	// └─ bind
+	//    └─ permission denied
+	//       ├─ errno
+	//       │  └─ 13
+	//       │
+	//       └─ errno name
+	//          └─ EACCES
+}
@@ -0,0 +1,45 @@
+package hierr
+
+import (
+	"reflect"
+)
+
+// ExitCodes is a mapping of values of context pairs with CodeKey key to exit
+// statuses of process, which are used by Fatalf(), so shell scripts, which
+// wrap CLI tools, can distinguish classes of failures:
+//
+//	hierr.ExitCodes = map[interface{}]int{
+//		"usage":     2,
+//		"not found": 3,
+//	}
+var ExitCodes = map[interface{}]int{}
+
+// DefaultExitCode is an exit status, which is used by Fatalf(), if error
+// has no code, which is mapped by ExitCodes.
+var DefaultExitCode = 1
+
+// ExitCode returns exit status for given error, which is mapped by
+// ExitCodes from value of the first context pair with CodeKey key, found in
+// order of descending into the tree, which has mapping. DefaultExitCode is
+// returned if there is no such pair, and zero is returned for nil error.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	for _, field := range AllFields(err) {
+		if field.Key != CodeKey || field.Value == nil {
+			continue
+		}
+
+		if !reflect.TypeOf(field.Value).Comparable() {
+			continue
+		}
+
+		if code, ok := ExitCodes[field.Value]; ok {
+			return code
+		}
+	}
+
+	return DefaultExitCode
+}
@@ -0,0 +1,32 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleExitCode() {
+	ExitCodes = map[interface{}]int{
+		"usage":     2,
+		"not found": 3,
+	}
+	defer func() {
+		ExitCodes = map[interface{}]int{}
+	}()
+
+	err := Errorf(
+		NewWith("no such profile", WithCode("not found")),
+		"can't load profile",
+	)
+
+	fmt.Println(ExitCode(err))
+	fmt.Println(ExitCode(NewWith("can't connect", WithCode(503))))
+	fmt.Println(ExitCode(errors.New("connection refused")))
+	fmt.Println(ExitCode(nil))
+
+	// Output:
+	// 3
+	// 1
+	// 1
+	// 0
+}
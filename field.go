@@ -0,0 +1,136 @@
+package hierr
+
+// Field represents key-value context pair, which is attached to error by
+// using Context(key, value) as nested error.
+type Field struct {
+	// Key is a name of context pair.
+	Key string
+
+	// Value is a value of context pair, which can be any printable object.
+	Value interface{}
+}
+
+// Decompose splits given error into top-level message, context fields and
+// reasons.
+//
+// Nested errors, created by Context(key, value), are returned as fields, all
+// other nested errors are returned as reasons. If given error is not
+// hierarchical and can't be decomposed by registered decomposers, then it's
+// considered as leaf and only message is returned.
+func Decompose(
+	node NestedError,
+) (message string, fields []Field, reasons []NestedError) {
+	node = dereference(node)
+
+	if decomposed, ok := decompose(node); ok {
+		node = decomposed
+	}
+
+	hierarchical, ok := node.(HierarchicalError)
+	if !ok {
+		return String(node), nil, nil
+	}
+
+	for _, nested := range hierarchical.GetNested() {
+		if field, ok := getField(nested); ok {
+			fields = append(fields, field)
+		} else {
+			reasons = append(reasons, nested)
+		}
+	}
+
+	return hierarchical.GetMessage(), fields, reasons
+}
+
+func getField(node NestedError) (Field, bool) {
+	err, ok := node.(Error)
+	if !ok {
+		return Field{}, false
+	}
+
+	children, ok := err.Nested.([]NestedError)
+	if !ok || len(children) != 1 {
+		return Field{}, false
+	}
+
+	switch children[0].(type) {
+	case nil, error, HierarchicalError:
+		return Field{}, false
+	}
+
+	return Field{Key: err.Message, Value: children[0]}, true
+}
+
+// AllFields returns context fields of given error and all its nested
+// reasons, in order of descending into the tree.
+func AllFields(node NestedError) []Field {
+	_, fields, reasons := Decompose(node)
+	for _, reason := range reasons {
+		fields = append(fields, AllFields(reason)...)
+	}
+
+	return fields
+}
+
+// GetContext returns context pairs, which are attached to the error.
+func (err Error) GetContext() []Field {
+	_, fields, _ := Decompose(err)
+
+	return fields
+}
+
+// GetValue returns value of context pair with given key, which is attached
+// to the error. If there are several pairs with the same key, value of the
+// first one is returned.
+func (err Error) GetValue(key string) (interface{}, bool) {
+	for _, field := range err.GetContext() {
+		if field.Key == key {
+			return field.Value, true
+		}
+	}
+
+	return nil, false
+}
+
+// WithValue returns copy of the error, where value of context pair with
+// given key is replaced with specified value. If there is no such pair, new
+// pair is added after all nested errors.
+func (err Error) WithValue(key string, value interface{}) Error {
+	nested := []NestedError{}
+	replaced := false
+
+	for _, child := range err.GetNested() {
+		if field, ok := getField(child); ok && field.Key == key {
+			child = Context(key, value)
+			replaced = true
+		}
+
+		nested = append(nested, child)
+	}
+
+	if !replaced {
+		nested = append(nested, Context(key, value))
+	}
+
+	err.Nested = nested
+
+	return err
+}
+
+// WithoutValue returns copy of the error without context pairs with given
+// key, which are attached to the error. Context pairs of nested reasons are
+// kept.
+func (err Error) WithoutValue(key string) Error {
+	nested := []NestedError{}
+	for _, child := range err.GetNested() {
+		if field, ok := getField(child); ok && field.Key == key {
+			continue
+		}
+
+		nested = append(nested, child)
+	}
+
+	err.Nested = nested
+
+	return err
+}
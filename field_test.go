@@ -0,0 +1,127 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleDecompose() {
+	testcases := []NestedError{
+		errors.New("plain error"),
+		Errorf(errors.New("low level"), "top level"),
+		Context(
+			Errorf(errors.New("fatal error"), "some error occured"),
+			Context("database", "localhost:1234"),
+			Context("node", "node-a.localdomain"),
+		),
+	}
+
+	for _, test := range testcases {
+		message, fields, reasons := Decompose(test)
+
+		fmt.Println()
+		fmt.Println("{{{")
+		fmt.Println("message:", message)
+		for _, field := range fields {
+			fmt.Printf("field: %s = %s\n", field.Key, field.Value)
+		}
+		for _, reason := range reasons {
+			fmt.Println("reason:", String(reason))
+		}
+		fmt.Println("}}}")
+	}
+
+	// Output:
+	//
+	// {{{
+	// message: plain error
+	// }}}
+	//
+	// {{{
+	// message: top level
+	// reason: low level
+	// }}}
+	//
+	// {{{
+	// message: some error occured
+	// field: database = localhost:1234
+	// field: node = node-a.localdomain
+	// reason: fatal error
+	// }}}
+}
+
+func ExampleAllFields() {
+	err := Context(
+		Errorf(
+			Context(
+				errors.New("exit status 128"),
+				Context("command", "git fetch"),
+			),
+			"can't pull remote",
+		),
+		Context("remote", "origin"),
+	)
+
+	for _, field := range AllFields(err) {
+		fmt.Printf("%s = %s\n", field.Key, field.Value)
+	}
+
+	// Output:
+	// remote = origin
+	// command = git fetch
+}
+
+func ExampleError_GetValue() {
+	err := Context(
+		Errorf(errors.New("not found"), "can't get user"),
+		Context("status_code", 404),
+	).(Error)
+
+	value, ok := err.GetValue("status_code")
+	fmt.Println(value, ok)
+
+	value, ok = err.GetValue("host")
+	fmt.Println(value, ok)
+
+	// Output:
+	// 404 true
+	// <nil> false
+}
+
+func ExampleError_WithValue() {
+	err := Context(
+		Errorf(errors.New("unauthorized"), "can't login"),
+		Context("token", "secret"),
+	).(Error)
+
+	fmt.Println(err.WithValue("token", "<redacted>").WithValue("user", "root"))
+
+	// Output:
+	// can't login
+	// ├─ unauthorized
+	// │
+	// ├─ token
+	// │  └─ <redacted>
+	// │
+	// └─ user
+	//    └─ root
+}
+
+func ExampleError_WithoutValue() {
+	err := Context(
+		Errorf(Context(errors.New("unauthorized"), Context("token", "nested")), "can't login"),
+		Context("token", "secret"),
+		Context("user", "root"),
+	).(Error)
+
+	fmt.Println(err.WithoutValue("token"))
+
+	// Output:
+	// can't login
+	// ├─ unauthorized
+	// │  └─ token
+	// │     └─ nested
+	// │
+	// └─ user
+	//    └─ root
+}
@@ -0,0 +1,58 @@
+package hierr
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Find returns every node of the error tree, matching given predicate, in
+// order of descending into the tree. Reasons, which are not hierr errors,
+// are passed to predicate as synthetic errors, as All() does.
+//
+//	nodes := err.Find(func(node hierr.Error) bool {
+//		_, ok := node.GetValue("status_code")
+//		return ok
+//	})
+func (err Error) Find(predicate func(Error) bool) []Error {
+	found := []Error{}
+	for node := range err.All() {
+		if predicate(node) {
+			found = append(found, node)
+		}
+	}
+
+	return found
+}
+
+// FindFirst returns the first node of the error tree, matching given
+// predicate, and true, or empty error and false if there is no such node.
+func (err Error) FindFirst(predicate func(Error) bool) (Error, bool) {
+	for node := range err.All() {
+		if predicate(node) {
+			return node, true
+		}
+	}
+
+	return Error{}, false
+}
+
+// ContainsMessage returns true if message of any node of the error tree
+// contains given substring. Unlike searching in rendered error, it doesn't
+// depend on branch delimiters and indentation settings.
+func (err Error) ContainsMessage(substring string) bool {
+	_, ok := err.FindFirst(func(node Error) bool {
+		return strings.Contains(node.Message, substring)
+	})
+
+	return ok
+}
+
+// MatchMessage returns true if message of any node of the error tree matches
+// given regular expression.
+func (err Error) MatchMessage(expression *regexp.Regexp) bool {
+	_, ok := err.FindFirst(func(node Error) bool {
+		return expression.MatchString(node.Message)
+	})
+
+	return ok
+}
@@ -0,0 +1,66 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+func ExampleError_Find() {
+	err := Push(
+		"can't deploy",
+		Context(
+			Errorf(errors.New("permission denied"), "can't read config"),
+			Context("status_code", 403),
+		),
+		Context(
+			Errorf(errors.New("not found"), "can't get release"),
+			Context("status_code", 404),
+		),
+	).(Error)
+
+	hasStatus := func(node Error) bool {
+		_, ok := node.GetValue("status_code")
+		return ok
+	}
+
+	for _, node := range err.Find(hasStatus) {
+		status, _ := node.GetValue("status_code")
+		fmt.Println(node.Message, status)
+	}
+
+	node, ok := err.FindFirst(func(node Error) bool {
+		return strings.Contains(node.Message, "permission denied")
+	})
+	fmt.Println(node.Message, ok)
+
+	_, ok = err.FindFirst(func(node Error) bool {
+		return node.Message == "timeout"
+	})
+	fmt.Println(ok)
+
+	// Output:
+	// can't read config 403
+	// can't get release 404
+	// permission denied true
+	// false
+}
+
+func ExampleError_ContainsMessage() {
+	err := Errorf(
+		Errorf(errors.New("dial tcp 10.0.0.1:5432: connection refused"), "can't connect"),
+		"can't run migrations",
+	).(Error)
+
+	fmt.Println(err.ContainsMessage("connection refused"))
+	fmt.Println(err.ContainsMessage("refused\n"))
+	fmt.Println(err.MatchMessage(regexp.MustCompile(`^dial tcp [\d.]+:5432`)))
+	fmt.Println(err.MatchMessage(regexp.MustCompile(`timeout`)))
+
+	// Output:
+	// true
+	// false
+	// true
+	// false
+}
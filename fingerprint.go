@@ -0,0 +1,68 @@
+package hierr
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"regexp"
+)
+
+var (
+	fingerprintUUID = regexp.MustCompile(
+		`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`,
+	)
+
+	fingerprintNumber = regexp.MustCompile(`0x[0-9a-fA-F]+|[0-9a-fA-F]*[0-9][0-9a-fA-F]*`)
+)
+
+// Fingerprint returns hash of normalized messages and structure of the
+// error tree, which can be used for grouping, deduplication and alert
+// routing. Messages are normalized by replacing numbers, hexadecimal
+// identifiers and UUIDs with placeholders, so volatile parts like
+// timestamps, IDs and ports don't affect fingerprint. Keys of context pairs
+// are taken into account, but their values are ignored.
+func (err Error) Fingerprint() string {
+	return Fingerprint(err)
+}
+
+// Fingerprint returns fingerprint of given error, as Error.Fingerprint()
+// does, so errors, which are not hierr errors, can be fingerprinted as well.
+func Fingerprint(node NestedError) string {
+	hash := sha256.New()
+
+	writeFingerprint(hash, node)
+
+	return hex.EncodeToString(hash.Sum(nil)[:8])
+}
+
+func writeFingerprint(hash io.Writer, node NestedError) {
+	message, fields, reasons := Decompose(node)
+
+	fmt.Fprintf(hash, "%q", NormalizeMessage(message))
+	for _, field := range fields {
+		fmt.Fprintf(hash, "(%q)", field.Key)
+	}
+
+	fmt.Fprint(hash, "[")
+	for _, reason := range reasons {
+		writeFingerprint(hash, reason)
+	}
+	fmt.Fprint(hash, "]")
+}
+
+// NormalizeMessage replaces numbers, hexadecimal identifiers and UUIDs in
+// given message with placeholders, as Fingerprint() does, so message can be
+// used as label of metrics without unbounded cardinality:
+//
+//	can't read block 1042 of volume 550e8400-e29b-41d4-a716-446655440000
+//
+// Becomes:
+//
+//	can't read block <n> of volume <uuid>
+func NormalizeMessage(message string) string {
+	message = fingerprintUUID.ReplaceAllString(message, "<uuid>")
+	message = fingerprintNumber.ReplaceAllString(message, "<n>")
+
+	return message
+}
@@ -0,0 +1,36 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleError_Fingerprint() {
+	request := func(id string, port int) Error {
+		return Push(
+			fmt.Sprintf("can't process request %s", id),
+			Errorf(
+				fmt.Errorf("dial tcp 10.0.0.1:%d: connection refused", port),
+				"can't connect",
+			),
+			Context("time", "2024-01-02T03:04:05Z"),
+		).(Error)
+	}
+
+	first := request("0b8e51a2-6bc4-4a1e-9d35-7b0f8b1c2d3e", 5432)
+	second := request("f3a4c5d6-1234-4bcd-8ef0-0123456789ab", 6543)
+
+	fmt.Println(first.Fingerprint() == second.Fingerprint())
+	fmt.Println(len(first.Fingerprint()))
+
+	third := Push(first, errors.New("disk is full")).(Error)
+
+	fmt.Println(first.Fingerprint() == third.Fingerprint())
+	fmt.Println(NormalizeMessage("request 0xdeadbeef took 15ms"))
+
+	// Output:
+	// true
+	// 16
+	// false
+	// request <n> took <n>ms
+}
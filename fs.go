@@ -0,0 +1,57 @@
+package hierr
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+)
+
+// IsNotExist returns true if any node of given error tree reports that file
+// or directory doesn't exist, according to os.IsNotExist() or errors.Is()
+// with fs.ErrNotExist, since wrapping hides such errors from standard
+// predicates.
+func IsNotExist(err error) bool {
+	return anyNode(err, func(node error) bool {
+		return os.IsNotExist(node) || errors.Is(node, fs.ErrNotExist)
+	})
+}
+
+// IsExist returns true if any node of given error tree reports that file or
+// directory already exists, according to os.IsExist() or errors.Is() with
+// fs.ErrExist.
+func IsExist(err error) bool {
+	return anyNode(err, func(node error) bool {
+		return os.IsExist(node) || errors.Is(node, fs.ErrExist)
+	})
+}
+
+// IsPermission returns true if any node of given error tree reports that
+// permission is denied, according to os.IsPermission() or errors.Is() with
+// fs.ErrPermission.
+func IsPermission(err error) bool {
+	return anyNode(err, func(node error) bool {
+		return os.IsPermission(node) || errors.Is(node, fs.ErrPermission)
+	})
+}
+
+// IsClosed returns true if any node of given error tree is fs.ErrClosed.
+func IsClosed(err error) bool {
+	return anyIs(err, fs.ErrClosed)
+}
+
+// anyNode returns true if predicate is true for any node of given error
+// tree, which is error.
+func anyNode(node NestedError, predicate func(error) bool) bool {
+	if err, ok := node.(error); ok && predicate(err) {
+		return true
+	}
+
+	_, _, reasons := Decompose(node)
+	for _, reason := range reasons {
+		if anyNode(reason, predicate) {
+			return true
+		}
+	}
+
+	return false
+}
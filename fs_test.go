@@ -0,0 +1,33 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+)
+
+func ExampleIsNotExist() {
+	_, openErr := os.Open("/nonexistent/app.conf")
+
+	err := Push(
+		"can't start",
+		Errorf(openErr, "can't load config"),
+		Errorf(&os.PathError{Op: "mkdir", Path: "/var/lib/app", Err: syscall.EACCES}, "can't create state"),
+	)
+
+	fmt.Println(os.IsNotExist(err))
+	fmt.Println(IsNotExist(err))
+	fmt.Println(IsPermission(err))
+	fmt.Println(IsExist(err))
+	fmt.Println(IsClosed(Errorf(os.ErrClosed, "can't write")))
+	fmt.Println(IsNotExist(errors.New("connection refused")))
+
+	// Output:
+	// false
+	// true
+	// true
+	// false
+	// true
+	// false
+}
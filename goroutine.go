@@ -0,0 +1,97 @@
+package hierr
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"runtime/pprof"
+	"strconv"
+)
+
+// GoroutineKey is a key of context pair, which contains ID of goroutine,
+// created error, if RecordGoroutine is set.
+const GoroutineKey = "goroutine"
+
+// PanicMessage set message of hierarchy error, which is created by Go(), if
+// function panics.
+var PanicMessage = "goroutine panicked"
+
+// Go runs given function in new goroutine and delivers its result on
+// returned channel, which is closed afterwards. If function panics, panic is
+// converted into hierarchy error with PanicMessage message, as Recover()
+// does, and delivered instead, so worker failures are always reported as
+// errors:
+//
+//	result := hierr.Go(func() error {
+//		return process(task)
+//	})
+//
+//	if err := <-result; err != nil {
+//		...
+//	}
+func Go(fn func() error) <-chan error {
+	result := make(chan error, 1)
+
+	go func() {
+		var err error
+
+		defer func() {
+			result <- err
+			close(result)
+		}()
+
+		defer Recover(&err, PanicMessage)
+
+		err = fn()
+	}()
+
+	return result
+}
+
+// WithLabels adds pprof labels from given context to the error as context
+// pairs, so errors, which are returned by workers, can be correlated with
+// their tasks:
+//
+//	pprof.Do(ctx, pprof.Labels("task", name), func(ctx context.Context) {
+//		err = hierr.WithLabels(ctx, process(ctx))
+//	})
+//
+// Labels are added in the order of their keys. If there are no labels or
+// err is nil, err is returned as is.
+func WithLabels(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	labels := []NestedError{}
+
+	pprof.ForLabels(ctx, func(key string, value string) bool {
+		labels = append(labels, Context(key, value))
+		return true
+	})
+
+	if len(labels) == 0 {
+		return err
+	}
+
+	return Push(err, labels...)
+}
+
+// goroutineID returns ID of current goroutine, which is parsed from the
+// header of goroutine stack trace, zero is returned if ID can't be parsed.
+func goroutineID() uint64 {
+	buffer := make([]byte, 64)
+	buffer = buffer[:runtime.Stack(buffer, false)]
+
+	buffer = bytes.TrimPrefix(buffer, []byte("goroutine "))
+	if space := bytes.IndexByte(buffer, ' '); space > 0 {
+		buffer = buffer[:space]
+	}
+
+	id, err := strconv.ParseUint(string(buffer), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return id
+}
@@ -0,0 +1,83 @@
+package hierr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"runtime/pprof"
+)
+
+func ExampleRecordGoroutine() {
+	defer func() {
+		RecordGoroutine = false
+	}()
+
+	RecordGoroutine = true
+
+	done := make(chan error)
+	go func() {
+		done <- Errorf(errors.New("exit status 128"), "can't pull remote")
+	}()
+
+	err := <-done
+
+	// goroutine ID differs from run to run
+	id := regexp.MustCompile(`\d+$`)
+
+	fmt.Println(id.ReplaceAllString(err.Error(), "N"))
+
+	// Output:
+	// can't pull remote
+	// ├─ exit status 128
+	// │
+	// └─ goroutine
+	//    └─ N
+}
+
+func ExampleWithLabels() {
+	var err error
+
+	pprof.Do(
+		context.Background(),
+		pprof.Labels("worker", "3", "task", "pull"),
+		func(ctx context.Context) {
+			err = WithLabels(ctx, Errorf(errors.New("timeout"), "can't pull"))
+		},
+	)
+
+	fmt.Println(err)
+
+	// Output:
+	// can't pull
+	// ├─ timeout
+	// │
+	// ├─ task
+	// │  └─ pull
+	// │
+	// └─ worker
+	//    └─ 3
+}
+
+func ExampleGo() {
+	fmt.Println(<-Go(func() error {
+		return nil
+	}))
+
+	fmt.Println(<-Go(func() error {
+		return errors.New("connection refused")
+	}))
+
+	fmt.Println(<-Go(func() error {
+		var tasks map[string]int
+		tasks["pull"] = 1
+
+		return nil
+	}))
+
+	// Output:
+	// <nil>
+	// connection refused
+	// goroutine panicked
+	// └─ assignment to entry in nil map
+}
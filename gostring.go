@@ -0,0 +1,68 @@
+package hierr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GoString returns Go code, which constructs equivalent error tree using
+// Errorf(), Push() and Context() calls, so errors, which are observed in
+// production, can be turned into test fixtures. It's used by %#v verb.
+//
+// Reasons, which are not hierarchical errors, are constructed by
+// errors.New(), string reasons are left as string literals and values of
+// context pairs are written using %#v verb.
+func (err Error) GoString() string {
+	return goString(err, "")
+}
+
+func goString(node NestedError, indent string) string {
+	node = dereference(node)
+
+	if decomposed, ok := decompose(node); ok {
+		node = decomposed
+	}
+
+	hierarchical, ok := node.(HierarchicalError)
+	if !ok {
+		if _, ok := node.(error); ok {
+			return fmt.Sprintf("errors.New(%q)", String(node))
+		}
+
+		return fmt.Sprintf("%q", String(node))
+	}
+
+	var (
+		message = hierarchical.GetMessage()
+		nested  = hierarchical.GetNested()
+		inner   = indent + "\t"
+	)
+
+	if len(nested) == 0 {
+		return fmt.Sprintf(
+			"hierr.Errorf(nil, %q)", strings.Replace(message, "%", "%%", -1),
+		)
+	}
+
+	if _, isField := getField(nested[0]); len(nested) == 1 && !isField {
+		return "hierr.Errorf(\n" +
+			inner + goString(nested[0], inner) + ",\n" +
+			inner + fmt.Sprintf("%q", strings.Replace(message, "%", "%%", -1)) +
+			",\n" + indent + ")"
+	}
+
+	code := "hierr.Push(\n" + inner + fmt.Sprintf("%q", message) + ",\n"
+	for _, child := range nested {
+		if field, ok := getField(child); ok {
+			code += inner + fmt.Sprintf(
+				"hierr.Context(%q, %#v)", field.Key, field.Value,
+			) + ",\n"
+
+			continue
+		}
+
+		code += inner + goString(child, inner) + ",\n"
+	}
+
+	return code + indent + ")"
+}
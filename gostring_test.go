@@ -0,0 +1,35 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleError_GoString() {
+	err := Push(
+		"can't deploy",
+		Errorf(errors.New("connection refused"), "can't connect to 100%% hosts"),
+		"disk is full",
+		Context("attempts", 3),
+	)
+
+	fmt.Printf("%#v\n", err)
+
+	// Output:
+	// hierr.Push(
+	// 	"can't deploy",
+	// 	hierr.Errorf(
+	// 		errors.New("connection refused"),
+	// 		"can't connect to 100%% hosts",
+	// 	),
+	// 	"disk is full",
+	// 	hierr.Context("attempts", 3),
+	// )
+}
+
+func ExampleError_GoString_leaf() {
+	fmt.Printf("%#v\n", Errorf(nil, "disk is full"))
+
+	// Output:
+	// hierr.Errorf(nil, "disk is full")
+}
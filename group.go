@@ -0,0 +1,71 @@
+package hierr
+
+import (
+	"sync"
+)
+
+// Group runs named tasks in goroutines and collects all their failures into
+// single error tree, unlike errgroup, which returns only the first error.
+// Zero value of Group is ready to use:
+//
+//	group := hierr.Group{Message: "can't deploy"}
+//	for _, host := range hosts {
+//		group.Go(host, func() error {
+//			return deploy(host)
+//		})
+//	}
+//
+//	err := group.Wait()
+type Group struct {
+	// Message is a message of error, returned by Wait(), MergeMessage is
+	// used if it's empty.
+	Message string
+
+	mutex sync.Mutex
+	tasks []task
+}
+
+type task struct {
+	name   string
+	result <-chan error
+}
+
+// Go runs given function in new goroutine as task with specified name.
+// Panics of function are converted into errors as Go() does.
+func (group *Group) Go(name string, fn func() error) {
+	result := Go(fn)
+
+	group.mutex.Lock()
+	defer group.mutex.Unlock()
+
+	group.tasks = append(group.tasks, task{name: name, result: result})
+}
+
+// Wait waits for all tasks to complete and returns hierarchy error, which
+// reasons are failed tasks in order of their start. Every reason has name of
+// failed task as message and error of task as nested error. Nil is returned
+// if no task has failed.
+func (group *Group) Wait() error {
+	group.mutex.Lock()
+	tasks := group.tasks
+	group.tasks = nil
+	group.mutex.Unlock()
+
+	failures := []NestedError{}
+	for _, task := range tasks {
+		if err := <-task.result; err != nil {
+			failures = append(failures, Error{Message: task.name, Nested: err})
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	message := group.Message
+	if message == "" {
+		message = MergeMessage
+	}
+
+	return Push(message, failures...)
+}
@@ -0,0 +1,38 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleGroup() {
+	group := Group{Message: "can't deploy"}
+
+	for _, host := range []string{"node-a", "node-b", "node-c"} {
+		group.Go(host, func() error {
+			switch host {
+			case "node-a":
+				return Errorf(errors.New("connection refused"), "can't connect")
+
+			case "node-c":
+				panic("unexpected state")
+			}
+
+			return nil
+		})
+	}
+
+	fmt.Println(group.Wait())
+	fmt.Println(group.Wait())
+
+	// Output:
+	// can't deploy
+	// ├─ node-a
+	// │  └─ can't connect
+	// │     └─ connection refused
+	// │
+	// └─ node-c
+	//    └─ goroutine panicked
+	//       └─ unexpected state
+	// <nil>
+}
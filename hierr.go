@@ -13,9 +13,12 @@
 package hierr // import "github.com/reconquest/hierr-go"
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
+	"time"
 	"unicode"
 )
 
@@ -68,9 +71,37 @@ var (
 
 	// BranchIndent set number of spaces each nested error will be indented by.
 	BranchIndent = 3
+
+	// RecordCaller set whether Errorf() should record file and line of the
+	// caller, which will be appended to error message when it's displayed.
+	// Caller is always recorded in debug mode.
+	RecordCaller = false
+
+	// Deduplicate set whether identical sibling subtrees should be collapsed
+	// into one node, annotated with number of occurrences, when error is
+	// displayed.
+	Deduplicate = false
+
+	// DuplicateCounter set format of annotation, which will be appended to
+	// the message of collapsed subtree, if Deduplicate is set.
+	DuplicateCounter = " ×%d"
+
+	// PlainErrors set whether Errorf() and ErrorfSkip() should return plain
+	// error, created by errors.New(), instead of hierarchy error if no nested
+	// error is specified.
+	PlainErrors = false
+
+	// RecordGoroutine set whether Errorf() should record ID of goroutine,
+	// which creates error, as context pair with GoroutineKey key.
+	RecordGoroutine = false
 )
 
 // Error represents hierarchy error, linked with nested error.
+//
+// Methods of Error have value receivers, so they can't be called on nil
+// *Error: such call panics as any call of value method through nil pointer
+// does. Nil pointers are supported only as nodes of the tree and by
+// package-level functions, like String() and AsError().
 type Error struct {
 	// Message is formatter error message, which will be reported when Error()
 	// will be invoked.
@@ -78,6 +109,46 @@ type Error struct {
 
 	// Nested error, which can be hierr.Error as well.
 	Nested interface{}
+
+	// Stack is a call stack, which is captured when error is created by
+	// ErrorfStack(), nil otherwise.
+	Stack *Stack
+
+	// Caller is a location, where error is created, which is recorded only if
+	// RecordCaller is set, nil otherwise.
+	Caller *Caller
+
+	// ID is an unique identifier of error, which is assigned only if
+	// RecordID is set, empty otherwise.
+	ID string
+
+	// Severity is a severity level of error, zero if it's not set, which
+	// means SeverityError.
+	Severity Severity
+
+	// hints are actionable suggestions, which are rendered after nested
+	// errors, see WithHint(). Hints are kept behind pointer, so errors stay
+	// comparable.
+	hints *[]string
+
+	// tags are labels of error, see WithTags(). Tags are kept behind
+	// pointer, so errors stay comparable.
+	tags *[]string
+
+	// attachments are named payloads of error, see WithAttachment().
+	attachments *[]Attachment
+
+	// PublicMessage is a message, which is safe to show to API clients and
+	// end-users, see RenderPublic().
+	PublicMessage string
+
+	// Visibility is a visibility of error for external audience, see
+	// RenderPublic().
+	Visibility Visibility
+
+	// Time is a creation time of error, which is recorded only if
+	// RecordTime is set, zero otherwise.
+	Time time.Time
 }
 
 // HierarchicalError represents interface, which methods will be used instead
@@ -102,19 +173,101 @@ type NestedError interface{}
 
 // Errorf creates new hierarchy error.
 //
-// With nestedError == nil call will be equal to `fmt.Errorf()`.
+// With nestedError == nil call will be equal to `fmt.Errorf()`. If
+// PlainErrors is set, then plain error is returned in that case.
 func Errorf(
 	nestedError NestedError,
 	message string,
 	args ...interface{},
 ) error {
-	return Error{
-		Message: fmt.Sprintf(message, args...),
+	if nestedError == nil && GetConfig().PlainErrors {
+		return errors.New(fmt.Sprintf(message, args...))
+	}
+
+	return newError(1, false, nestedError, fmt.Sprintf(message, args...))
+}
+
+// New creates new hierarchy error as Errorf() does, but uses message as is,
+// without formatting, so static messages, which contain % characters, can be
+// used without escaping.
+func New(nestedError NestedError, message string) error {
+	if nestedError == nil && GetConfig().PlainErrors {
+		return errors.New(message)
+	}
+
+	return newError(1, false, nestedError, message)
+}
+
+// ErrorfSkip creates new hierarchy error as Errorf() does, but skips
+// specified number of additional frames when recording caller, so helper
+// functions, which wrap ErrorfSkip(), can report location of their callers.
+func ErrorfSkip(
+	skip int,
+	nestedError NestedError,
+	message string,
+	args ...interface{},
+) error {
+	if nestedError == nil && GetConfig().PlainErrors {
+		return errors.New(fmt.Sprintf(message, args...))
+	}
+
+	return newError(
+		skip+1, false, nestedError, fmt.Sprintf(message, args...),
+	)
+}
+
+// newError creates new hierarchy error, recording metadata of the caller,
+// which is located specified number of frames above caller of newError.
+// Call stack is captured if stack is set or debug mode is enabled.
+func newError(
+	skip int,
+	stack bool,
+	nestedError NestedError,
+	message string,
+) Error {
+	err := Error{
+		Message: message,
 		Nested:  nestedError,
+		Caller:  recordCaller(skip + 1),
+	}
+
+	if stack {
+		err.Stack = captureStack(skip + 1)
+	} else {
+		err.Stack = debugStack(skip + 1)
+	}
+
+	settings := GetConfig()
+
+	if settings.RecordID {
+		err.ID = assignID(nestedError)
 	}
+
+	if settings.RecordTime {
+		err.Time = time.Now()
+	}
+
+	if settings.RecordGoroutine {
+		err.Nested = append(
+			err.GetNested(),
+			Context(GoroutineKey, goroutineID()),
+		)
+	}
+
+	if journal := defaultJournal.Load(); journal != nil {
+		journal.Record(err)
+	}
+
+	if settings.ReportCreated {
+		report(err)
+	}
+
+	return err
 }
 
-// Fatalf creates new hierarchy error, prints to stderr and exit 1
+// Fatalf creates new hierarchy error, prints to stderr and exits with
+// status, which is returned by ExitCode(), so it is DefaultExitCode, unless
+// code of error is mapped by ExitCodes.
 //
 // Have same semantics as `hierr.Errorf()`.
 func Fatalf(
@@ -122,43 +275,144 @@ func Fatalf(
 	message string,
 	args ...interface{},
 ) {
-	fmt.Fprintln(os.Stderr, Errorf(nestedError, message, args...))
-	exiter(1)
+	err := newError(1, false, nestedError, fmt.Sprintf(message, args...))
+
+	fmt.Fprintln(os.Stderr, err)
+	exiter(ExitCode(err))
 }
 
 // Error returns string representation of hierarchical error. If no nested
 // error was specified, then only current error message will be returned.
+// In debug mode verbose representation is returned.
+//
+// Rendering is a pure function of the error tree: neither error nor its
+// nested reasons are modified, so error can be rendered any number of times
+// and concurrently.
 func (err Error) Error() string {
+	return err.format(newRendering(IsDebug()))
+}
+
+// Format implements fmt.Formatter interface. Verbs %s and %v return the same
+// string as Error() does, verb %+v returns verbose representation, which
+// includes captured call stacks of every error in the tree, and verb %#v
+// returns Go code, which constructs the tree, as GoString() does.
+func (err Error) Format(state fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if state.Flag('#') {
+			io.WriteString(state, err.GoString())
+			return
+		}
+
+		if state.Flag('+') {
+			io.WriteString(state, err.format(newRendering(true)))
+			return
+		}
+
+		io.WriteString(state, err.Error())
+
+	case 's':
+		io.WriteString(state, err.Error())
+
+	case 'q':
+		fmt.Fprintf(state, "%q", err.Error())
+
+	default:
+		fmt.Fprintf(state, "%%!%c(hierr.Error=%s)", verb, err.Error())
+	}
+}
+
+func (err Error) format(options rendering) string {
+	message := err.Message
+	if err.Caller != nil && options.callers {
+		location := err.Caller.String()
+		if options.hyperlinks {
+			location = hyperlink(location, err.Caller, options.template)
+		}
+
+		message += " (" + location + ")"
+	}
+
+	if err.ID != "" && options.ids && err.ID != options.id {
+		message += " [error ref: " + err.ID + "]"
+	}
+
+	options.id = err.ID
+
+	if options.colors {
+		message = colorize(message, err.Severity)
+	}
+
+	if options.verbose && !err.Time.IsZero() {
+		if options.epoch.IsZero() {
+			options.epoch = earliestTime(err)
+		}
+
+		message += formatOffset(err.Time, options.epoch)
+	}
+
+	if options.verbose && err.Stack != nil {
+		message += formatStack(err.Stack, options.stack)
+		options.stack = err.Stack
+	}
+
+	attachments := err.GetAttachments()
+	hints := err.GetHints()
+
+	if len(attachments) > 0 || len(hints) > 0 {
+		children := append(
+			err.GetNested(), formatAttachments(attachments, options)...,
+		)
+
+		return formatNestedError(
+			message,
+			append(children, formatHints(hints, options)...),
+			options,
+		)
+	}
+
 	switch children := err.Nested.(type) {
 	case nil:
-		return err.Message
+		return message
 
 	case []NestedError:
-		return formatNestedError(err, children)
+		return formatNestedError(message, expand(children), options)
 
 	default:
-		return err.Message + "\n" +
-			BranchDelimiter +
+		if _, ok := multiErrors(children); ok {
+			return formatNestedError(
+				message, expand([]NestedError{children}), options,
+			)
+		}
+
+		return message + "\n" +
+			options.delimiter +
 			strings.Replace(
-				String(err.Nested),
+				render(err.Nested, options),
 				"\n",
-				"\n"+strings.Repeat(" ", BranchIndent),
+				"\n"+strings.Repeat(" ", options.indent),
 				-1,
 			)
 	}
 }
 
-// GetNested returns nested errors, embedded into error.
+// GetNested returns nested errors, embedded into error. Returned slice is a
+// copy, so it can be modified without affecting the error.
+//
+// Multi-error containers, like *multierror.Error of
+// github.com/hashicorp/go-multierror or errors of go.uber.org/multierr, are
+// expanded, so errors, which they contain, are returned as sibling reasons.
 func (err Error) GetNested() []NestedError {
-	children, ok := err.Nested.([]NestedError)
-	if !ok {
-		children = []NestedError{}
-		if err.Nested != nil {
-			children = append(children, err.Nested)
-		}
-	}
+	switch nested := err.Nested.(type) {
+	case nil:
+		return []NestedError{}
+
+	case []NestedError:
+		return expand(nested)
 
-	return children
+	default:
+		return expand([]NestedError{nested})
+	}
 }
 
 // GetMessage returns top-level error message.
@@ -181,14 +435,11 @@ func Push(topError NestedError, childError ...NestedError) error {
 		}
 	}
 
-	children := parent.GetNested()
+	children := append(parent.GetNested(), childError...)
 
-	children = append(children, childError...)
+	parent.Nested = children
 
-	return Error{
-		Message: parent.Message,
-		Nested:  children,
-	}
+	return parent
 }
 
 // Context adds context to specified top-level node.
@@ -204,7 +455,20 @@ func Context(node NestedError, description ...NestedError) error {
 	return Push(node, description...)
 }
 
+// String returns string representation of nested error or value of context
+// pair. Values of time.Duration are rounded to three fractional digits of
+// the largest unit and values of time.Time are formatted using RFC 3339.
 func String(object interface{}) string {
+	object = dereference(object)
+
+	switch value := object.(type) {
+	case time.Duration:
+		return formatDuration(value)
+
+	case time.Time:
+		return formatTime(value)
+	}
+
 	if hierr, ok := object.(HierarchicalError); ok {
 		return hierr.HierarchicalError()
 	}
@@ -213,15 +477,128 @@ func String(object interface{}) string {
 		return err.Error()
 	}
 
-	return fmt.Sprintf("%s", object)
+	switch object.(type) {
+	case string, []byte, fmt.Stringer:
+		return fmt.Sprintf("%s", object)
+	}
+
+	return fmt.Sprintf("%v", object)
 }
 
-func formatNestedError(err Error, children []NestedError) string {
-	message := err.Message
+// rendering represents options of rendering error tree.
+type rendering struct {
+	// verbose enables rendering of captured call stacks.
+	verbose bool
+
+	// stack is a call stack of the closest parent, which has captured stack.
+	stack *Stack
+
+	// delimiter, chainer, splitter and indent are branch glyphs and
+	// indentation, which are used to draw the tree.
+	delimiter string
+	chainer   string
+	splitter  string
+	indent    int
+
+	// callers enables rendering of recorded caller locations.
+	callers bool
+
+	// hyperlinks enables rendering of caller locations as hyperlinks, which
+	// URLs are made using template.
+	hyperlinks bool
+	template   string
+
+	// deduplicate enables collapsing of identical siblings, which are
+	// annotated using counter format.
+	deduplicate bool
+	counter     string
+
+	// sorted enables sorting of context pairs by their keys.
+	sorted bool
+
+	// ids enables rendering of error IDs, which are not rendered if they
+	// are the same as id of the parent.
+	ids bool
+	id  string
+
+	// colors enables coloring of messages according to severity.
+	colors bool
+
+	// epoch is the earliest creation time of nodes of rendered tree, which
+	// is used to render offsets in verbose mode.
+	epoch time.Time
+
+	// errno enables decomposing of syscall errors, see ExplainErrno.
+	errno bool
+
+	// hint and attachment are prefixes of rendered hints and attachments.
+	hint       string
+	attachment string
+}
+
+// newRendering returns rendering options, which are set by current
+// settings.
+func newRendering(verbose bool) rendering {
+	settings := GetConfig()
+
+	return rendering{
+		verbose:     verbose,
+		delimiter:   settings.BranchDelimiter,
+		chainer:     settings.BranchChainer,
+		splitter:    settings.BranchSplitter,
+		indent:      settings.BranchIndent,
+		callers:     true,
+		hyperlinks:  settings.Hyperlinks,
+		template:    settings.HyperlinkTemplate,
+		deduplicate: settings.Deduplicate,
+		counter:     settings.DuplicateCounter,
+		ids:         true,
+		colors:      settings.Colorize,
+		errno:       settings.ExplainErrno,
+		hint:        settings.HintPrefix,
+		attachment:  settings.AttachmentPrefix,
+	}
+}
+
+func render(object interface{}, options rendering) string {
+	object = dereference(object)
+
+	if decomposed, ok := decomposeNode(object, options.errno); ok {
+		object = decomposed
+	}
+
+	if err, ok := object.(Error); ok {
+		return err.format(options)
+	}
+
+	return String(object)
+}
+
+func formatNestedError(
+	message string,
+	children []NestedError,
+	options rendering,
+) string {
+	if options.sorted {
+		children = sortContext(children)
+	}
+
+	rendered := make([]string, len(children))
+	for index, child := range children {
+		rendered[index] = render(child, options)
+	}
+
+	if options.deduplicate {
+		children, rendered = deduplicate(children, rendered, options.counter)
+	}
 
 	prolongate := false
 	for _, child := range children {
-		if childError, ok := child.(HierarchicalError); ok {
+		if decomposed, ok := decomposeNode(child, options.errno); ok {
+			child = decomposed
+		}
+
+		if childError, ok := dereference(child).(HierarchicalError); ok {
 			errs := childError.GetNested()
 			if len(errs) > 0 {
 				prolongate = true
@@ -230,21 +607,21 @@ func formatNestedError(err Error, children []NestedError) string {
 		}
 	}
 
-	for index, child := range children {
+	for index := range children {
 		var (
-			splitter      = BranchSplitter
-			chainer       = BranchChainer
-			chainerLength = len([]rune(BranchChainer))
+			splitter      = options.splitter
+			chainer       = options.chainer
+			chainerLength = len([]rune(options.chainer))
 		)
 
 		if index == len(children)-1 {
-			splitter = BranchDelimiter
+			splitter = options.delimiter
 			chainer = strings.Repeat(" ", chainerLength)
 		}
 
 		indentation := chainer
-		if BranchIndent >= chainerLength {
-			indentation += strings.Repeat(" ", BranchIndent-chainerLength)
+		if options.indent >= chainerLength {
+			indentation += strings.Repeat(" ", options.indent-chainerLength)
 		}
 
 		prolongator := ""
@@ -257,7 +634,7 @@ func formatNestedError(err Error, children []NestedError) string {
 		message = message + "\n" +
 			splitter +
 			strings.Replace(
-				String(child),
+				rendered[index],
 				"\n",
 				"\n"+indentation,
 				-1,
@@ -267,3 +644,49 @@ func formatNestedError(err Error, children []NestedError) string {
 
 	return message
 }
+
+// deduplicate collapses identical rendered children into the first
+// occurrence, which message is annotated with number of occurrences.
+func deduplicate(
+	children []NestedError,
+	rendered []string,
+	counter string,
+) ([]NestedError, []string) {
+	var (
+		uniqueChildren = []NestedError{}
+		uniqueRendered = []string{}
+		counts         = []int{}
+		positions      = map[string]int{}
+	)
+
+	for index, text := range rendered {
+		if position, ok := positions[text]; ok {
+			counts[position]++
+			continue
+		}
+
+		positions[text] = len(uniqueRendered)
+
+		uniqueChildren = append(uniqueChildren, children[index])
+		uniqueRendered = append(uniqueRendered, text)
+		counts = append(counts, 1)
+	}
+
+	for index, count := range counts {
+		if count == 1 {
+			continue
+		}
+
+		text := uniqueRendered[index]
+
+		end := strings.Index(text, "\n")
+		if end < 0 {
+			end = len(text)
+		}
+
+		uniqueRendered[index] = text[:end] +
+			fmt.Sprintf(counter, count) + text[end:]
+	}
+
+	return uniqueChildren, uniqueRendered
+}
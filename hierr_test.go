@@ -17,6 +17,7 @@ func ExampleError() {
 		Errorf(Errorf(errors.New("low level"), "nested"), "top level"),
 		Errorf(Errorf(fmt.Sprintf("%s", "string"), "nested"), "top level"),
 		Errorf([]byte("byte"), "top level"),
+		Errorf(128, "exit status"),
 	}
 
 	for _, test := range testcases {
@@ -94,12 +95,44 @@ func ExampleError() {
 	// └─ byte
 	// }}}
 	//
+	// {{{
+	// exit status
+	// └─ 128
+	// }}}
+	//
 	// exit code: 1
 	// stderr:
 	// critical error
 	// └─ wow
 }
 
+func ExampleError_Error() {
+	err := Context(
+		Errorf(errors.New("connection refused"), "can't connect"),
+		Context("host", "example.com"),
+	).(Error)
+
+	first := err.Error()
+	second := err.Error()
+
+	fmt.Println(first == second)
+	fmt.Println(len(err.GetNested()))
+
+	// Output:
+	// true
+	// 2
+}
+
+func ExampleNew() {
+	fmt.Println(New(errors.New("disk is full"), "can't write 100% of data"))
+	fmt.Println(New(nil, "can't write 100% of data"))
+
+	// Output:
+	// can't write 100% of data
+	// └─ disk is full
+	// can't write 100% of data
+}
+
 func ExampleBranchDelimiter() {
 	defer func() {
 		BranchDelimiter = BranchDelimiterBox
@@ -154,6 +187,45 @@ func ExampleBranchIndent() {
 	// }}}
 }
 
+func ExampleDeduplicate() {
+	defer func() {
+		Deduplicate = false
+	}()
+
+	Deduplicate = true
+
+	refused := func() error {
+		return Errorf(errors.New("connection refused"), "can't connect")
+	}
+
+	testcases := []error{
+		Push(
+			"can't deploy",
+			refused(),
+			errors.New("disk is full"),
+			refused(),
+			refused(),
+		),
+	}
+
+	for _, test := range testcases {
+		fmt.Println()
+		fmt.Println("{{{")
+		fmt.Println(test.Error())
+		fmt.Println("}}}")
+	}
+
+	// Output:
+	//
+	// {{{
+	// can't deploy
+	// ├─ can't connect ×3
+	// │  └─ connection refused
+	// │
+	// └─ disk is full
+	// }}}
+}
+
 func ExamplePush() {
 	testcases := []error{
 		Push(
@@ -219,7 +291,7 @@ type smartError struct {
 }
 
 func (smart smartError) HierarchicalError() string {
-	return Errorf(smart.Err, smart.Text).Error()
+	return Errorf(smart.Err, "%s", smart.Text).Error()
 }
 
 func (smart smartError) GetNested() []NestedError {
@@ -230,6 +302,34 @@ func (smart smartError) GetMessage() string {
 	return smart.Text
 }
 
+func ExamplePush_shared() {
+	nested := make([]NestedError, 1, 4)
+	nested[0] = errors.New("connection refused")
+
+	shared := Error{Message: "can't connect", Nested: nested}
+
+	first := Push(shared, Context("host", "node-a"))
+	second := Push(shared, Context("host", "node-b"))
+
+	fmt.Println(first)
+	fmt.Println(second)
+	fmt.Println(shared)
+
+	// Output:
+	// can't connect
+	// ├─ connection refused
+	// │
+	// └─ host
+	//    └─ node-a
+	// can't connect
+	// ├─ connection refused
+	// │
+	// └─ host
+	//    └─ node-b
+	// can't connect
+	// └─ connection refused
+}
+
 func ExampleContext() {
 	testcases := []error{
 		Context(
@@ -0,0 +1,180 @@
+// Package hierraws decomposes deeply wrapped errors of AWS SDK into
+// hierarchical errors with error code, HTTP status and request ID as context
+// pairs, since request IDs are required by AWS support:
+//
+//	can't download artifact
+//	└─ The specified key does not exist.
+//	   ├─ service
+//	   │  └─ S3
+//	   │
+//	   ├─ operation
+//	   │  └─ GetObject
+//	   │
+//	   ├─ error code
+//	   │  └─ NoSuchKey
+//	   │
+//	   ├─ http status
+//	   │  └─ 404
+//	   │
+//	   └─ request id
+//	      └─ 4442587FB7D0A2F9
+//
+// Package doesn't import AWS SDK, instead errors of aws-sdk-go-v2 and
+// aws-sdk-go are recognized by methods of their interfaces, like
+// ErrorCode() and ServiceRequestID() of smithy-go errors or Code() and
+// RequestID() of awserr errors.
+package hierraws // import "github.com/reconquest/hierr-go/hierraws"
+
+import (
+	"errors"
+
+	"github.com/reconquest/hierr-go"
+)
+
+const (
+	// ServiceKey is a key of context pair, which contains ID of AWS service.
+	ServiceKey = "service"
+
+	// OperationKey is a key of context pair, which contains name of
+	// operation.
+	OperationKey = hierr.OperationKey
+
+	// ErrorCodeKey is a key of context pair, which contains AWS error code.
+	ErrorCodeKey = "error code"
+
+	// HTTPStatusKey is a key of context pair, which contains HTTP status
+	// code of AWS response. It differs from hierr.HTTPStatusKey, so status
+	// of AWS response is not used as status of own response.
+	HTTPStatusKey = "http status"
+
+	// RequestIDKey is a key of context pair, which contains AWS request ID.
+	RequestIDKey = "request id"
+)
+
+// RequestFailedMessage set message of error, which is returned by
+// Decompose(), if error has no AWS error code.
+var RequestFailedMessage = "AWS request failed"
+
+type (
+	// operationError is implemented by smithy.OperationError.
+	operationError interface {
+		Service() string
+		Operation() string
+	}
+
+	// apiError is implemented by smithy.APIError.
+	apiError interface {
+		ErrorCode() string
+		ErrorMessage() string
+	}
+
+	// legacyError is implemented by awserr.Error.
+	legacyError interface {
+		Code() string
+		Message() string
+	}
+
+	// requestID is implemented by awshttp.ResponseError.
+	requestID interface {
+		ServiceRequestID() string
+	}
+
+	// legacyRequestID is implemented by awserr.RequestFailure.
+	legacyRequestID interface {
+		RequestID() string
+	}
+
+	// httpStatus is implemented by awshttp.ResponseError.
+	httpStatus interface {
+		HTTPStatusCode() int
+	}
+
+	// legacyHTTPStatus is implemented by awserr.RequestFailure.
+	legacyHTTPStatus interface {
+		StatusCode() int
+	}
+)
+
+// Decompose converts AWS SDK error into hierarchical error, which message is
+// message of AWS error and context pairs contain service, operation, error
+// code, HTTP status and request ID, if they are present in the chain of
+// error. If there is no AWS error code in the chain, error with
+// RequestFailedMessage is returned, which reason is the innermost error of
+// the chain. Errors, which are not produced by AWS SDK, are returned as is.
+func Decompose(err error) error {
+	var (
+		message string
+		code    string
+		nested  = []hierr.NestedError{}
+	)
+
+	var api apiError
+	var legacy legacyError
+
+	switch {
+	case errors.As(err, &api):
+		code, message = api.ErrorCode(), api.ErrorMessage()
+
+	case errors.As(err, &legacy):
+		code, message = legacy.Code(), legacy.Message()
+	}
+
+	var operation operationError
+	if errors.As(err, &operation) {
+		nested = append(
+			nested,
+			hierr.Context(ServiceKey, operation.Service()),
+			hierr.Context(OperationKey, operation.Operation()),
+		)
+	}
+
+	if code != "" {
+		nested = append(nested, hierr.Context(ErrorCodeKey, code))
+	}
+
+	var status httpStatus
+	var legacyStatus legacyHTTPStatus
+
+	switch {
+	case errors.As(err, &status):
+		nested = append(nested, hierr.Context(HTTPStatusKey, status.HTTPStatusCode()))
+
+	case errors.As(err, &legacyStatus):
+		nested = append(nested, hierr.Context(HTTPStatusKey, legacyStatus.StatusCode()))
+	}
+
+	var id requestID
+	var legacyID legacyRequestID
+
+	switch {
+	case errors.As(err, &id) && id.ServiceRequestID() != "":
+		nested = append(nested, hierr.Context(RequestIDKey, id.ServiceRequestID()))
+
+	case errors.As(err, &legacyID) && legacyID.RequestID() != "":
+		nested = append(nested, hierr.Context(RequestIDKey, legacyID.RequestID()))
+	}
+
+	if len(nested) == 0 {
+		return err
+	}
+
+	if code == "" {
+		message = RequestFailedMessage
+		nested = append([]hierr.NestedError{innermost(err)}, nested...)
+	} else if message == "" {
+		message = code
+	}
+
+	return hierr.Push(message, nested...)
+}
+
+func innermost(err error) error {
+	for {
+		inner := errors.Unwrap(err)
+		if inner == nil {
+			return err
+		}
+
+		err = inner
+	}
+}
@@ -0,0 +1,132 @@
+package hierraws
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// operationFailure mimics smithy.OperationError.
+type operationFailure struct {
+	service   string
+	operation string
+	err       error
+}
+
+func (err *operationFailure) Service() string   { return err.service }
+func (err *operationFailure) Operation() string { return err.operation }
+func (err *operationFailure) Unwrap() error     { return err.err }
+
+func (err *operationFailure) Error() string {
+	return fmt.Sprintf(
+		"operation error %s: %s, %s", err.service, err.operation, err.err,
+	)
+}
+
+// responseFailure mimics awshttp.ResponseError.
+type responseFailure struct {
+	status int
+	id     string
+	err    error
+}
+
+func (err *responseFailure) HTTPStatusCode() int      { return err.status }
+func (err *responseFailure) ServiceRequestID() string { return err.id }
+func (err *responseFailure) Unwrap() error            { return err.err }
+
+func (err *responseFailure) Error() string {
+	return fmt.Sprintf(
+		"https response error StatusCode: %d, RequestID: %s, %s",
+		err.status, err.id, err.err,
+	)
+}
+
+// apiFailure mimics smithy.GenericAPIError.
+type apiFailure struct {
+	code    string
+	message string
+}
+
+func (err *apiFailure) ErrorCode() string    { return err.code }
+func (err *apiFailure) ErrorMessage() string { return err.message }
+
+func (err *apiFailure) Error() string {
+	return fmt.Sprintf("api error %s: %s", err.code, err.message)
+}
+
+// legacyFailure mimics awserr.RequestFailure.
+type legacyFailure struct{}
+
+func (legacyFailure) Code() string      { return "Throttling" }
+func (legacyFailure) Message() string   { return "Rate exceeded" }
+func (legacyFailure) StatusCode() int   { return 400 }
+func (legacyFailure) RequestID() string { return "b0f1c3a2" }
+func (legacyFailure) Error() string     { return "Throttling: Rate exceeded" }
+
+func ExampleDecompose() {
+	err := &operationFailure{
+		service:   "S3",
+		operation: "GetObject",
+		err: &responseFailure{
+			status: 404,
+			id:     "4442587FB7D0A2F9",
+			err: &apiFailure{
+				code:    "NoSuchKey",
+				message: "The specified key does not exist.",
+			},
+		},
+	}
+
+	fmt.Println(hierr.Errorf(Decompose(err), "can't download artifact"))
+
+	// Output:
+	// can't download artifact
+	// └─ The specified key does not exist.
+	//    ├─ service
+	//    │  └─ S3
+	//    │
+	//    ├─ operation
+	//    │  └─ GetObject
+	//    │
+	//    ├─ error code
+	//    │  └─ NoSuchKey
+	//    │
+	//    ├─ http status
+	//    │  └─ 404
+	//    │
+	//    └─ request id
+	//       └─ 4442587FB7D0A2F9
+}
+
+func ExampleDecompose_legacy() {
+	fmt.Println(Decompose(legacyFailure{}))
+
+	fmt.Println(Decompose(&operationFailure{
+		service:   "SQS",
+		operation: "SendMessage",
+		err:       errors.New("connection reset by peer"),
+	}))
+
+	fmt.Println(Decompose(errors.New("timeout")))
+
+	// Output:
+	// Rate exceeded
+	// ├─ error code
+	// │  └─ Throttling
+	// │
+	// ├─ http status
+	// │  └─ 400
+	// │
+	// └─ request id
+	//    └─ b0f1c3a2
+	// AWS request failed
+	// ├─ connection reset by peer
+	// │
+	// ├─ service
+	// │  └─ SQS
+	// │
+	// └─ operation
+	//    └─ SendMessage
+	// timeout
+}
@@ -0,0 +1,160 @@
+// Package hierrbugsnag converts hierarchical errors into Bugsnag error
+// reporting API (payload version 5) events.
+//
+// Every node of error tree is reported as separate exception, starting from
+// top-level one, followed by its causes, and context pairs are reported as
+// "context" tab of event metadata. Call stacks, captured by
+// hierr.ErrorfStack(), are reported as stacktraces of exceptions, which
+// correspond to nodes, which captured them.
+package hierrbugsnag // import "github.com/reconquest/hierr-go/hierrbugsnag"
+
+import (
+	"reflect"
+	"runtime"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// PayloadVersion is version of Bugsnag payload, which is produced by
+// package.
+const PayloadVersion = "5"
+
+// Notifier describes notifier, which sends payload.
+type Notifier struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	URL     string `json:"url"`
+}
+
+// Payload represents Bugsnag notify API request body.
+type Payload struct {
+	APIKey         string   `json:"apiKey"`
+	PayloadVersion string   `json:"payloadVersion"`
+	Notifier       Notifier `json:"notifier"`
+	Events         []Event  `json:"events"`
+}
+
+// Event represents single reported error.
+type Event struct {
+	Exceptions []Exception                       `json:"exceptions"`
+	Severity   string                            `json:"severity"`
+	Unhandled  bool                              `json:"unhandled"`
+	Context    string                            `json:"context,omitempty"`
+	MetaData   map[string]map[string]interface{} `json:"metaData,omitempty"`
+}
+
+// Exception represents single exception of event.
+type Exception struct {
+	ErrorClass string       `json:"errorClass"`
+	Message    string       `json:"message"`
+	Type       string       `json:"type"`
+	Stacktrace []StackFrame `json:"stacktrace"`
+}
+
+// StackFrame represents single frame of exception stacktrace.
+type StackFrame struct {
+	File       string `json:"file"`
+	LineNumber int    `json:"lineNumber"`
+	Method     string `json:"method"`
+	InProject  bool   `json:"inProject,omitempty"`
+}
+
+// NewPayload returns payload with given events, which can be sent to
+// Bugsnag notify API.
+func NewPayload(apiKey string, events ...Event) Payload {
+	return Payload{
+		APIKey:         apiKey,
+		PayloadVersion: PayloadVersion,
+		Notifier: Notifier{
+			Name:    "hierr",
+			Version: "1",
+			URL:     "https://github.com/reconquest/hierr-go",
+		},
+		Events: events,
+	}
+}
+
+// ToEvent returns Bugsnag event for given error, which severity is returned
+// by Severity().
+func ToEvent(err error) Event {
+	event := Event{
+		Exceptions: []Exception{},
+		Severity:   Severity(err),
+	}
+
+	if err == nil {
+		return event
+	}
+
+	flatten(err, &event.Exceptions)
+
+	fields := hierr.AllFields(err)
+	if len(fields) > 0 {
+		context := map[string]interface{}{}
+		for _, field := range fields {
+			if _, ok := context[field.Key]; !ok {
+				context[field.Key] = hierr.String(field.Value)
+			}
+		}
+
+		event.MetaData = map[string]map[string]interface{}{
+			"context": context,
+		}
+	}
+
+	return event
+}
+
+// Severity returns Bugsnag severity, which corresponds to severity of given
+// error, which is returned by hierr.GetSeverity(). Bugsnag has no debug and
+// fatal severities, so they are reported as "info" and "error".
+func Severity(err error) string {
+	switch hierr.GetSeverity(err) {
+	case hierr.SeverityDebug, hierr.SeverityInfo:
+		return "info"
+	case hierr.SeverityWarn:
+		return "warning"
+	}
+
+	return "error"
+}
+
+func flatten(node hierr.NestedError, exceptions *[]Exception) {
+	message, _, reasons := hierr.Decompose(node)
+
+	stacktrace := []StackFrame{}
+	for _, frame := range frames(node) {
+		stacktrace = append(stacktrace, StackFrame{
+			File:       frame.File,
+			LineNumber: frame.Line,
+			Method:     frame.Function,
+		})
+	}
+
+	*exceptions = append(*exceptions, Exception{
+		ErrorClass: reflect.TypeOf(node).String(),
+		Message:    message,
+		Type:       "go",
+		Stacktrace: stacktrace,
+	})
+
+	for _, reason := range reasons {
+		flatten(reason, exceptions)
+	}
+}
+
+// frames returns frames of call stack, which is captured by given node
+// itself.
+func frames(node hierr.NestedError) []runtime.Frame {
+	switch err := node.(type) {
+	case hierr.Error:
+		return err.Stack.Frames()
+
+	case *hierr.Error:
+		if err != nil {
+			return err.Stack.Frames()
+		}
+	}
+
+	return nil
+}
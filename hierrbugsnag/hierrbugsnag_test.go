@@ -0,0 +1,79 @@
+package hierrbugsnag
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleToEvent() {
+	err := hierr.Context(
+		hierr.Errorf(errors.New("exit status 128"), "can't pull remote"),
+		hierr.Context("host", "node-a"),
+	)
+
+	payload, _ := json.MarshalIndent(ToEvent(err), "", "  ")
+
+	fmt.Println(string(payload))
+
+	// Output:
+	// {
+	//   "exceptions": [
+	//     {
+	//       "errorClass": "hierr.Error",
+	//       "message": "can't pull remote",
+	//       "type": "go",
+	//       "stacktrace": []
+	//     },
+	//     {
+	//       "errorClass": "*errors.errorString",
+	//       "message": "exit status 128",
+	//       "type": "go",
+	//       "stacktrace": []
+	//     }
+	//   ],
+	//   "severity": "error",
+	//   "unhandled": false,
+	//   "metaData": {
+	//     "context": {
+	//       "host": "node-a"
+	//     }
+	//   }
+	// }
+}
+
+func ExampleSeverity() {
+	fmt.Println(Severity(errors.New("connection refused")))
+	fmt.Println(Severity(hierr.NewWith("cache miss", hierr.WithSeverity(hierr.SeverityDebug))))
+	fmt.Println(Severity(hierr.NewWith("retrying", hierr.WithSeverity(hierr.SeverityWarn))))
+	fmt.Println(ToEvent(hierr.NewWith("out of disk", hierr.WithSeverity(hierr.SeverityFatal))).Severity)
+
+	// Output:
+	// error
+	// info
+	// warning
+	// error
+}
+
+func ExampleToEvent_stack() {
+	err := hierr.Errorf(
+		hierr.ErrorfStack(errors.New("exit status 128"), "can't pull remote"),
+		"can't deploy",
+	)
+
+	for _, exception := range ToEvent(err).Exceptions {
+		if len(exception.Stacktrace) == 0 {
+			fmt.Println(exception.Message, "-")
+			continue
+		}
+
+		fmt.Println(exception.Message, exception.Stacktrace[0].Method)
+	}
+
+	// Output:
+	// can't deploy -
+	// can't pull remote github.com/reconquest/hierr-go/hierrbugsnag.ExampleToEvent_stack
+	// exit status 128 -
+}
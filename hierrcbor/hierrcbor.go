@@ -0,0 +1,29 @@
+// Package hierrcbor encodes hierarchical errors into CBOR and decodes them
+// back, so error trees can be embedded into binary telemetry pipelines,
+// where JSON overhead matters.
+//
+// Error trees are encoded in form of hierr.SerialNode, which nodes are
+// encoded as arrays of message and children, values of context pairs are
+// converted to strings.
+package hierrcbor // import "github.com/reconquest/hierr-go/hierrcbor"
+
+import (
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// Marshal encodes the whole error tree, including context pairs, into CBOR.
+func Marshal(err error) ([]byte, error) {
+	return cbor.Marshal(hierr.ToSerial(err))
+}
+
+// Unmarshal decodes error tree, encoded by Marshal().
+func Unmarshal(data []byte) (error, error) {
+	node := hierr.SerialNode{}
+	if err := cbor.Unmarshal(data, &node); err != nil {
+		return nil, hierr.Errorf(err, "can't decode error tree")
+	}
+
+	return hierr.FromSerial(node), nil
+}
@@ -0,0 +1,46 @@
+package hierrcbor
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleMarshal() {
+	err := hierr.Push(
+		"can't create job",
+		hierr.Context("job", 42),
+		hierr.Errorf(errors.New("disk is full"), "can't write spool"),
+	)
+
+	data, marshalErr := Marshal(err)
+	if marshalErr != nil {
+		panic(marshalErr)
+	}
+
+	decoded, unmarshalErr := Unmarshal(data)
+	if unmarshalErr != nil {
+		panic(unmarshalErr)
+	}
+
+	fmt.Println(decoded)
+	fmt.Println(decoded.Error() == err.Error())
+
+	// Output:
+	// can't create job
+	// ├─ job
+	// │  └─ 42
+	// │
+	// └─ can't write spool
+	//    └─ disk is full
+	// true
+}
+
+func ExampleUnmarshal() {
+	_, err := Unmarshal([]byte{0xc1})
+	fmt.Println(err != nil)
+
+	// Output:
+	// true
+}
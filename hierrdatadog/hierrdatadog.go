@@ -0,0 +1,76 @@
+// Package hierrdatadog converts hierarchical errors into attributes, which
+// are used by Datadog Error Tracking.
+//
+// Package doesn't import dd-trace-go, instead spans are used via Span
+// interface, which is implemented by ddtrace spans:
+//
+//	hierrdatadog.Tag(span, err)
+package hierrdatadog // import "github.com/reconquest/hierr-go/hierrdatadog"
+
+import (
+	"reflect"
+
+	"github.com/reconquest/hierr-go"
+)
+
+const (
+	// KeyKind is attribute, which contains type of error.
+	KeyKind = "error.kind"
+
+	// KeyMessage is attribute, which contains top-level error message.
+	KeyMessage = "error.message"
+
+	// KeyStack is attribute, which contains call stack, captured by the
+	// deepest node of error tree.
+	KeyStack = "error.stack"
+
+	// KeyTree is attribute, which contains rendered error tree.
+	KeyTree = "error.tree"
+)
+
+// Span represents subset of tracing span methods, which are used to attach
+// error attributes.
+type Span interface {
+	SetTag(key string, value interface{})
+}
+
+// Attributes returns error attributes for given error. Context pairs of the
+// whole tree are returned as additional attributes, uppermost values take
+// precedence, error attributes can't be overridden by context. Stack
+// attribute is set only if error tree contains captured call stack.
+func Attributes(err error) map[string]string {
+	if err == nil {
+		return map[string]string{}
+	}
+
+	message, _, _ := hierr.Decompose(err)
+
+	attributes := map[string]string{}
+	for _, field := range hierr.AllFields(err) {
+		if _, ok := attributes[field.Key]; !ok {
+			attributes[field.Key] = hierr.String(field.Value)
+		}
+	}
+
+	attributes[KeyKind] = reflect.TypeOf(err).String()
+	attributes[KeyMessage] = message
+	attributes[KeyTree] = hierr.String(err)
+
+	if stack := hierr.GetStack(err); stack != nil {
+		attributes[KeyStack] = stack.String()
+	}
+
+	return attributes
+}
+
+// Tag sets error attributes of given error as tags of specified span. Nil
+// error is ignored.
+func Tag(span Span, err error) {
+	if err == nil {
+		return
+	}
+
+	for key, value := range Attributes(err) {
+		span.SetTag(key, value)
+	}
+}
@@ -0,0 +1,44 @@
+package hierrdatadog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/reconquest/hierr-go"
+)
+
+type span map[string]interface{}
+
+func (span span) SetTag(key string, value interface{}) {
+	span[key] = value
+}
+
+func ExampleTag() {
+	err := hierr.Context(
+		hierr.Errorf(errors.New("exit status 128"), "can't pull remote"),
+		hierr.Context("host", "node-a"),
+		hierr.Context(KeyMessage, "overridden"),
+	)
+
+	tags := span{}
+
+	Tag(tags, err)
+	Tag(tags, nil)
+
+	fmt.Printf("%q\n", tags)
+
+	// Output:
+	// map["error.kind":"hierr.Error" "error.message":"can't pull remote" "error.tree":"can't pull remote\n├─ exit status 128\n│\n├─ host\n│  └─ node-a\n│\n└─ error.message\n   └─ overridden" "host":"node-a"]
+}
+
+func ExampleAttributes_stack() {
+	err := hierr.ErrorfStack(errors.New("exit status 128"), "can't pull remote")
+
+	attributes := Attributes(err)
+
+	fmt.Println(strings.Fields(attributes[KeyStack])[0])
+
+	// Output:
+	// github.com/reconquest/hierr-go/hierrdatadog.ExampleAttributes_stack
+}
@@ -0,0 +1,82 @@
+// Package hierrecs converts hierarchical errors into Elastic Common Schema
+// (ECS) fields.
+//
+// Resulting document can be merged into ECS log event, so errors land into
+// Elasticsearch without custom ingest pipelines:
+//
+//	{
+//	  "error": {
+//	    "message": "can't pull remote",
+//	    "type": "hierr.Error",
+//	    "tree": "can't pull remote\n└─ exit status 128",
+//	    "stack_trace": "main.pull (/src/main.go:42)\nmain.main (/src/main.go:17)"
+//	  },
+//	  "labels": {
+//	    "remote": "origin"
+//	  }
+//	}
+package hierrecs // import "github.com/reconquest/hierr-go/hierrecs"
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// Document contains ECS fields, describing error.
+type Document struct {
+	Error  Error             `json:"error"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// Error represents ECS error field set.
+type Error struct {
+	// Message is top-level error message.
+	Message string `json:"message"`
+
+	// Type is type of top-level error.
+	Type string `json:"type"`
+
+	// Tree is rendered error tree.
+	Tree string `json:"tree"`
+
+	// StackTrace is call stack, captured by the deepest node of error tree,
+	// it's empty if no stack is captured.
+	StackTrace string `json:"stack_trace,omitempty"`
+}
+
+// ToECS returns ECS document for given error. Context pairs of the whole tree
+// are flattened into labels, where dots in keys are replaced with
+// underscores, as ECS labels must not contain nested objects. If the same key
+// is used several times, the uppermost value is used. Stack trace is filled
+// only if error tree contains captured call stack.
+func ToECS(err error) Document {
+	if err == nil {
+		return Document{}
+	}
+
+	message, _, _ := hierr.Decompose(err)
+
+	document := Document{
+		Error: Error{
+			Message:    message,
+			Type:       reflect.TypeOf(err).String(),
+			Tree:       hierr.String(err),
+			StackTrace: hierr.GetStack(err).String(),
+		},
+	}
+
+	for _, field := range hierr.AllFields(err) {
+		if document.Labels == nil {
+			document.Labels = map[string]string{}
+		}
+
+		key := strings.Replace(field.Key, ".", "_", -1)
+		if _, ok := document.Labels[key]; !ok {
+			document.Labels[key] = hierr.String(field.Value)
+		}
+	}
+
+	return document
+}
@@ -0,0 +1,55 @@
+package hierrecs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleToECS() {
+	err := hierr.Context(
+		hierr.Errorf(
+			hierr.Context(
+				errors.New("exit status 128"),
+				hierr.Context("remote", "upstream"),
+			),
+			"can't pull remote",
+		),
+		hierr.Context("remote", "origin"),
+		hierr.Context("git.version", "2.14"),
+	)
+
+	document, _ := json.MarshalIndent(ToECS(err), "", "  ")
+
+	fmt.Println(string(document))
+
+	// Output:
+	// {
+	//   "error": {
+	//     "message": "can't pull remote",
+	//     "type": "hierr.Error",
+	//     "tree": "can't pull remote\n├─ exit status 128\n│  └─ remote\n│     └─ upstream\n│\n├─ remote\n│  └─ origin\n│\n└─ git.version\n   └─ 2.14"
+	//   },
+	//   "labels": {
+	//     "git_version": "2.14",
+	//     "remote": "origin"
+	//   }
+	// }
+}
+
+func ExampleToECS_stack() {
+	err := hierr.ErrorfStack(errors.New("exit status 128"), "can't pull remote")
+
+	document := ToECS(err)
+
+	fmt.Println(document.Error.Tree)
+	fmt.Println(strings.Fields(document.Error.StackTrace)[0])
+
+	// Output:
+	// can't pull remote
+	// └─ exit status 128
+	// github.com/reconquest/hierr-go/hierrecs.ExampleToECS_stack
+}
@@ -0,0 +1,123 @@
+// Package hierrexec runs external commands and reports their failures as
+// hierarchical errors, which contain command line, exit code and captured
+// standard error output:
+//
+//	can't run command
+//	├─ exit status 128
+//	│
+//	├─ command
+//	│  └─ git fetch origin 'refs/tokens/*:refs/tokens/*'
+//	│
+//	├─ exit code
+//	│  └─ 128
+//	│
+//	└─ stderr
+//	   └─ fatal: 'origin' does not appear to be a git repository
+package hierrexec // import "github.com/reconquest/hierr-go/hierrexec"
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os/exec"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/reconquest/hierr-go"
+)
+
+const (
+	// CommandKey is a key of context pair, which contains command line.
+	CommandKey = "command"
+
+	// ExitCodeKey is a key of context pair, which contains exit code of
+	// command.
+	ExitCodeKey = "exit code"
+
+	// StderrKey is a key of context pair, which contains captured standard
+	// error output of command.
+	StderrKey = "stderr"
+)
+
+// MaxStderr set maximum number of bytes of standard error output, which will
+// be reported in error. Only the last bytes are reported, since failure
+// reasons are usually printed at the end. Output is cut at rune boundary, so
+// fewer bytes can be reported.
+var MaxStderr = 4096
+
+// RunCommand runs given command and waits for it to complete. Standard
+// error output of command is captured, and if command has own
+// cmd.Stderr, output is also written there.
+//
+// If command fails, hierarchy error is returned, which contains original
+// error, command line, exit code, if command has exited, and captured
+// standard error output, truncated to MaxStderr bytes, as context pairs.
+func RunCommand(cmd *exec.Cmd) error {
+	stderr := &bytes.Buffer{}
+
+	if cmd.Stderr != nil {
+		cmd.Stderr = io.MultiWriter(cmd.Stderr, stderr)
+	} else {
+		cmd.Stderr = stderr
+	}
+
+	err := cmd.Run()
+	if err == nil {
+		return nil
+	}
+
+	nested := []hierr.NestedError{
+		err,
+		hierr.Context(CommandKey, CommandLine(cmd)),
+	}
+
+	var exit *exec.ExitError
+	if errors.As(err, &exit) {
+		nested = append(nested, hierr.Context(ExitCodeKey, exit.ExitCode()))
+	}
+
+	if output := truncate(stderr.String()); output != "" {
+		nested = append(nested, hierr.Context(StderrKey, output))
+	}
+
+	return hierr.Push("can't run command", nested...)
+}
+
+// CommandLine returns command line of given command, where arguments, which
+// contain whitespace or shell special characters, are quoted.
+func CommandLine(cmd *exec.Cmd) string {
+	args := cmd.Args
+	if len(args) == 0 {
+		args = []string{cmd.Path}
+	}
+
+	quoted := []string{}
+	for _, arg := range args {
+		quoted = append(quoted, quote(arg))
+	}
+
+	return strings.Join(quoted, " ")
+}
+
+func quote(arg string) string {
+	if arg != "" && !strings.ContainsAny(arg, " \t\n'\"\\$`*?[]{}()<>|&;#~!") {
+		return arg
+	}
+
+	return "'" + strings.Replace(arg, "'", `'\''`, -1) + "'"
+}
+
+func truncate(output string) string {
+	output = strings.TrimRight(output, "\n")
+
+	if MaxStderr > 0 && len(output) > MaxStderr {
+		start := len(output) - MaxStderr
+		for start < len(output) && !utf8.RuneStart(output[start]) {
+			start++
+		}
+
+		output = "..." + output[start:]
+	}
+
+	return output
+}
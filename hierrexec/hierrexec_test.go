@@ -0,0 +1,75 @@
+package hierrexec
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+func ExampleRunCommand() {
+	fmt.Println(RunCommand(exec.Command("sh", "-c", "exit 0")))
+
+	fmt.Println(RunCommand(exec.Command(
+		"sh", "-c", "echo 'fatal: no such remote' >&2; exit 128",
+	)))
+
+	// Output:
+	// <nil>
+	// can't run command
+	// ├─ exit status 128
+	// │
+	// ├─ command
+	// │  └─ sh -c 'echo '\''fatal: no such remote'\'' >&2; exit 128'
+	// │
+	// ├─ exit code
+	// │  └─ 128
+	// │
+	// └─ stderr
+	//    └─ fatal: no such remote
+}
+
+func ExampleCommandLine() {
+	fmt.Println(CommandLine(exec.Command(
+		"git", "fetch", "origin", "refs/tokens/*:refs/tokens/*",
+	)))
+
+	// Output:
+	// git fetch origin 'refs/tokens/*:refs/tokens/*'
+}
+
+func Example_truncate() {
+	defer func() {
+		MaxStderr = 4096
+	}()
+
+	MaxStderr = 10
+
+	err := RunCommand(exec.Command("sh", "-c", "echo 0123456789abcdef >&2; exit 1"))
+
+	fmt.Println(err)
+
+	// Output:
+	// can't run command
+	// ├─ exit status 1
+	// │
+	// ├─ command
+	// │  └─ sh -c 'echo 0123456789abcdef >&2; exit 1'
+	// │
+	// ├─ exit code
+	// │  └─ 1
+	// │
+	// └─ stderr
+	//    └─ ...6789abcdef
+}
+
+func Example_truncateRunes() {
+	defer func() {
+		MaxStderr = 4096
+	}()
+
+	MaxStderr = 5
+
+	fmt.Println(truncate("ошибка\n"))
+
+	// Output:
+	// ...ка
+}
@@ -0,0 +1,12 @@
+// Package hierrexpvar keeps recent hierarchical errors of running service
+// and exposes them as JSON trees via expvar or debug HTTP handler, so
+// operators can inspect structured failures without grepping logs:
+//
+//	recorder := hierrexpvar.Publish("errors", 100)
+//
+//	if err := process(); err != nil {
+//		recorder.Record(err)
+//	}
+//
+//	http.Handle("/debug/errors", recorder)
+package hierrexpvar // import "github.com/reconquest/hierr-go/hierrexpvar"
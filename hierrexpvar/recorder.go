@@ -0,0 +1,102 @@
+package hierrexpvar
+
+import (
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/reconquest/hierr-go"
+)
+
+var now = time.Now
+
+// Record represents recorded error.
+type Record struct {
+	// Time is a moment, when error is recorded.
+	Time time.Time `json:"time"`
+
+	// Error is an error tree, which is produced by hierr.Error.ToMap().
+	Error map[string]interface{} `json:"error"`
+}
+
+// Recorder keeps specified number of the most recent errors. Recorder
+// implements expvar.Var and http.Handler interfaces, which expose recorded
+// errors as JSON array, where the oldest error goes first.
+type Recorder struct {
+	mutex   sync.Mutex
+	size    int
+	records []Record
+}
+
+// NewRecorder returns recorder, which keeps size most recent errors.
+func NewRecorder(size int) *Recorder {
+	return &Recorder{size: size}
+}
+
+// Publish creates recorder, which keeps size most recent errors, and
+// publishes it as expvar variable with given name, so recorded errors are
+// exposed by /debug/vars handler. Publish panics if name is already
+// registered, as expvar.Publish() does.
+func Publish(name string, size int) *Recorder {
+	recorder := NewRecorder(size)
+
+	expvar.Publish(name, recorder)
+
+	return recorder
+}
+
+// Record records error, evicting the oldest one, if recorder is full. Nil
+// errors are ignored. Chains of wrapped errors are converted into trees
+// using hierr.FromError().
+func (recorder *Recorder) Record(err error) {
+	if err == nil || recorder.size <= 0 {
+		return
+	}
+
+	record := Record{
+		Time:  now(),
+		Error: hierr.Push(hierr.FromError(err)).(hierr.Error).ToMap(),
+	}
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	recorder.records = append(recorder.records, record)
+	if len(recorder.records) > recorder.size {
+		recorder.records = append(
+			[]Record{}, recorder.records[len(recorder.records)-recorder.size:]...,
+		)
+	}
+}
+
+// Records returns recorded errors, where the oldest error goes first.
+func (recorder *Recorder) Records() []Record {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	return append([]Record{}, recorder.records...)
+}
+
+// String returns recorded errors as JSON array, it implements expvar.Var
+// interface.
+func (recorder *Recorder) String() string {
+	data, err := json.Marshal(recorder.Records())
+	if err != nil {
+		data, _ = json.Marshal(err.Error())
+	}
+
+	return string(data)
+}
+
+// ServeHTTP writes recorded errors as JSON array, it implements
+// http.Handler interface.
+func (recorder *Recorder) ServeHTTP(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+	writer.Header().Set("Content-Type", "application/json")
+
+	_, _ = writer.Write([]byte(recorder.String()))
+}
@@ -0,0 +1,37 @@
+package hierrexpvar
+
+import (
+	"errors"
+	"expvar"
+	"fmt"
+	"net/http/httptest"
+	"time"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleRecorder() {
+	now = func() time.Time {
+		return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	}
+
+	recorder := Publish("errors", 2)
+
+	recorder.Record(errors.New("connection refused"))
+	recorder.Record(hierr.Errorf(errors.New("disk is full"), "can't write"))
+	recorder.Record(fmt.Errorf("can't load config: %w", errors.New("not found")))
+	recorder.Record(nil)
+
+	fmt.Println(expvar.Get("errors"))
+
+	response := httptest.NewRecorder()
+	recorder.ServeHTTP(response, httptest.NewRequest("GET", "/debug/errors", nil))
+
+	fmt.Println(response.Header().Get("Content-Type"))
+	fmt.Println(len(recorder.Records()))
+
+	// Output:
+	// [{"time":"2024-01-02T03:04:05Z","error":{"message":"can't write","reasons":[{"message":"disk is full"}]}},{"time":"2024-01-02T03:04:05Z","error":{"message":"can't load config","reasons":[{"message":"not found"}]}}]
+	// application/json
+	// 2
+}
@@ -0,0 +1,130 @@
+// Package hierrgcp renders hierarchical errors as Cloud Logging structured
+// entries, which are recognized by Google Cloud Error Reporting.
+//
+// Error Reporting groups Go errors only if message contains stack trace, so
+// rendered error tree is followed by call stack, which is captured when
+// error is created, or by stack trace of goroutine, which reported error, if
+// no stack is captured:
+//
+//	json.NewEncoder(os.Stderr).Encode(hierrgcp.Report(err, service))
+package hierrgcp // import "github.com/reconquest/hierr-go/hierrgcp"
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// ReportedErrorEventType is value of "@type" field, which marks entry as
+// error event.
+const ReportedErrorEventType = "type.googleapis.com/" +
+	"google.devtools.clouderrorreporting.v1beta1.ReportedErrorEvent"
+
+// ServiceContext describes service, which reported error.
+type ServiceContext struct {
+	Service string `json:"service"`
+	Version string `json:"version,omitempty"`
+}
+
+// ReportLocation describes source location, where error was reported.
+type ReportLocation struct {
+	FilePath     string `json:"filePath"`
+	LineNumber   int    `json:"lineNumber"`
+	FunctionName string `json:"functionName"`
+}
+
+// Context contains additional information about reported error.
+type Context struct {
+	ReportLocation *ReportLocation `json:"reportLocation,omitempty"`
+}
+
+// Entry represents structured log entry, which should be written as JSON
+// line to the stdout or stderr of service.
+type Entry struct {
+	Severity       string            `json:"severity"`
+	Type           string            `json:"@type"`
+	Message        string            `json:"message"`
+	ServiceContext ServiceContext    `json:"serviceContext"`
+	Context        *Context          `json:"context,omitempty"`
+	Labels         map[string]string `json:"logging.googleapis.com/labels,omitempty"`
+}
+
+// Report returns log entry for given error, which severity is returned by
+// Severity(). Context pairs of the whole tree are reported as entry labels.
+func Report(err error, service ServiceContext) Entry {
+	entry := Entry{
+		Severity:       Severity(err),
+		Type:           ReportedErrorEventType,
+		Message:        hierr.String(err) + "\n\n" + stack(err),
+		ServiceContext: service,
+	}
+
+	if pc, file, line, ok := runtime.Caller(1); ok {
+		location := &ReportLocation{
+			FilePath:   file,
+			LineNumber: line,
+		}
+
+		if function := runtime.FuncForPC(pc); function != nil {
+			location.FunctionName = function.Name()
+		}
+
+		entry.Context = &Context{ReportLocation: location}
+	}
+
+	for _, field := range hierr.AllFields(err) {
+		if entry.Labels == nil {
+			entry.Labels = map[string]string{}
+		}
+
+		if _, ok := entry.Labels[field.Key]; !ok {
+			entry.Labels[field.Key] = hierr.String(field.Value)
+		}
+	}
+
+	return entry
+}
+
+// Severity returns Cloud Logging severity, which corresponds to severity of
+// given error, which is returned by hierr.GetSeverity().
+func Severity(err error) string {
+	switch hierr.GetSeverity(err) {
+	case hierr.SeverityDebug:
+		return "DEBUG"
+	case hierr.SeverityInfo:
+		return "INFO"
+	case hierr.SeverityWarn:
+		return "WARNING"
+	case hierr.SeverityFatal:
+		return "CRITICAL"
+	}
+
+	return "ERROR"
+}
+
+// stack returns call stack, which is captured by error, formatted as
+// runtime.Stack() does, so Error Reporting can parse it, or stack trace of
+// current goroutine, if error has no captured stack.
+func stack(err error) string {
+	if captured := hierr.GetStack(err); captured != nil {
+		trace := "goroutine 1 [running]:\n"
+		for _, frame := range captured.Frames() {
+			trace += fmt.Sprintf(
+				"%s(...)\n\t%s:%d\n", frame.Function, frame.File, frame.Line,
+			)
+		}
+
+		return trace
+	}
+
+	buffer := make([]byte, 4096)
+	for {
+		size := runtime.Stack(buffer, false)
+		if size < len(buffer) {
+			return string(buffer[:size])
+		}
+
+		buffer = make([]byte, len(buffer)*2)
+	}
+}
@@ -0,0 +1,75 @@
+package hierrgcp
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleReport() {
+	err := hierr.Context(
+		hierr.Errorf(errors.New("exit status 128"), "can't pull remote"),
+		hierr.Context("host", "node-a"),
+	)
+
+	entry := Report(err, ServiceContext{Service: "deployer", Version: "1.0"})
+
+	lines := strings.Split(entry.Message, "\n")
+
+	fmt.Println(entry.Severity)
+	fmt.Println(entry.Type)
+	fmt.Println(entry.ServiceContext)
+	fmt.Println(entry.Labels)
+	fmt.Println(filepath.Base(entry.Context.ReportLocation.FilePath))
+	fmt.Println(entry.Context.ReportLocation.FunctionName)
+	fmt.Println(strings.Join(lines[:6], "\n"))
+	fmt.Println(strings.HasPrefix(lines[6], "goroutine "))
+
+	// Output:
+	// ERROR
+	// type.googleapis.com/google.devtools.clouderrorreporting.v1beta1.ReportedErrorEvent
+	// {deployer 1.0}
+	// map[host:node-a]
+	// hierrgcp_test.go
+	// github.com/reconquest/hierr-go/hierrgcp.ExampleReport
+	// can't pull remote
+	// ├─ exit status 128
+	// │
+	// └─ host
+	//    └─ node-a
+	//
+	// true
+}
+
+func ExampleReport_stack() {
+	err := hierr.ErrorfStack(errors.New("exit status 128"), "can't pull remote")
+
+	lines := strings.Split(Report(err, ServiceContext{Service: "deployer"}).Message, "\n")
+
+	fmt.Println(lines[3])
+	fmt.Println(lines[4])
+	fmt.Println(filepath.Base(strings.Split(lines[5], ":")[0]))
+
+	// Output:
+	// goroutine 1 [running]:
+	// github.com/reconquest/hierr-go/hierrgcp.ExampleReport_stack(...)
+	// hierrgcp_test.go
+}
+
+func ExampleSeverity() {
+	err := errors.New("connection refused")
+
+	fmt.Println(Severity(err))
+	fmt.Println(Severity(hierr.NewWith("cache miss", hierr.WithSeverity(hierr.SeverityDebug))))
+	fmt.Println(Severity(hierr.NewWith("retrying", hierr.WithSeverity(hierr.SeverityWarn))))
+	fmt.Println(Severity(hierr.NewWith("out of disk", hierr.WithSeverity(hierr.SeverityFatal))))
+
+	// Output:
+	// ERROR
+	// DEBUG
+	// WARNING
+	// CRITICAL
+}
@@ -0,0 +1,85 @@
+// Package hierrgelf renders hierarchical errors as GELF (Graylog Extended
+// Log Format) messages.
+//
+// Top-level error message is used as short_message, rendered tree is used
+// as full_message and context pairs of the whole tree are passed as
+// additional fields, prefixed with underscore.
+package hierrgelf // import "github.com/reconquest/hierr-go/hierrgelf"
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// Version is GELF specification version, produced by package.
+const Version = "1.1"
+
+// DefaultLevel is syslog level of GELF messages, which is used for errors
+// without severity.
+const DefaultLevel = 3
+
+var invalidNameRegexp = regexp.MustCompile(`[^\w\.\-]`)
+
+// Message returns GELF message for given error, which can be encoded as JSON
+// and sent to Graylog.
+//
+// Additional field values are kept as is if they are strings or numbers,
+// all other values are converted to strings. Field names are sanitized to
+// contain only allowed symbols, "_id" field is renamed to "__id" as it's
+// reserved by Graylog. If the same key is used several times, the uppermost
+// value is used. Level of message corresponds to severity of error, see
+// Level().
+func Message(err error, host string, timestamp time.Time) map[string]interface{} {
+	message, _, _ := hierr.Decompose(err)
+
+	gelf := map[string]interface{}{}
+	for _, field := range hierr.AllFields(err) {
+		name := "_" + invalidNameRegexp.ReplaceAllString(field.Key, "_")
+		if name == "_id" {
+			name = "__id"
+		}
+
+		if _, ok := gelf[name]; ok {
+			continue
+		}
+
+		switch value := field.Value.(type) {
+		case string,
+			int, int8, int16, int32, int64,
+			uint, uint8, uint16, uint32, uint64,
+			float32, float64:
+			gelf[name] = value
+		default:
+			gelf[name] = hierr.String(value)
+		}
+	}
+
+	gelf["version"] = Version
+	gelf["host"] = host
+	gelf["short_message"] = message
+	gelf["full_message"] = hierr.String(err)
+	gelf["timestamp"] = float64(timestamp.UnixNano()/int64(time.Millisecond)) / 1000
+	gelf["level"] = Level(err)
+
+	return gelf
+}
+
+// Level returns syslog level of GELF message, which corresponds to severity
+// of given error, which is returned by hierr.GetSeverity(). Fatal errors are
+// reported with critical level.
+func Level(err error) int {
+	switch hierr.GetSeverity(err) {
+	case hierr.SeverityDebug:
+		return 7
+	case hierr.SeverityInfo:
+		return 6
+	case hierr.SeverityWarn:
+		return 4
+	case hierr.SeverityFatal:
+		return 2
+	}
+
+	return DefaultLevel
+}
@@ -0,0 +1,55 @@
+package hierrgelf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleMessage() {
+	err := hierr.Context(
+		hierr.Errorf(errors.New("exit status 128"), "can't pull remote"),
+		hierr.Context("remote", "origin"),
+		hierr.Context("attempt", 3),
+		hierr.Context("id", "abc"),
+		hierr.Context("git version", []byte("2.14")),
+	)
+
+	timestamp := time.Date(2017, 8, 24, 10, 0, 0, 500000000, time.UTC)
+
+	message, _ := json.MarshalIndent(Message(err, "node-a", timestamp), "", "  ")
+
+	fmt.Println(string(message))
+
+	// Output:
+	// {
+	//   "__id": "abc",
+	//   "_attempt": 3,
+	//   "_git_version": "2.14",
+	//   "_remote": "origin",
+	//   "full_message": "can't pull remote\n├─ exit status 128\n│\n├─ remote\n│  └─ origin\n│\n├─ attempt\n│  └─ 3\n│\n├─ id\n│  └─ abc\n│\n└─ git version\n   └─ 2.14",
+	//   "host": "node-a",
+	//   "level": 3,
+	//   "short_message": "can't pull remote",
+	//   "timestamp": 1503568800.5,
+	//   "version": "1.1"
+	// }
+}
+
+func ExampleLevel() {
+	fmt.Println(Level(errors.New("connection refused")))
+	fmt.Println(Level(hierr.NewWith("cache miss", hierr.WithSeverity(hierr.SeverityDebug))))
+	fmt.Println(Level(hierr.NewWith("retrying", hierr.WithSeverity(hierr.SeverityInfo))))
+	fmt.Println(Level(hierr.NewWith("slow query", hierr.WithSeverity(hierr.SeverityWarn))))
+	fmt.Println(Message(hierr.NewWith("out of disk", hierr.WithSeverity(hierr.SeverityFatal)), "node-a", time.Time{})["level"])
+
+	// Output:
+	// 3
+	// 7
+	// 6
+	// 4
+	// 2
+}
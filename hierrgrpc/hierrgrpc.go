@@ -0,0 +1,34 @@
+// Package hierrgrpc transfers hierarchical errors over gRPC, so calls
+// between services keep hierarchy of reasons and context.
+//
+// Server interceptors convert hierarchical errors, which are returned by
+// handlers, into gRPC status errors, where the whole tree is stored in
+// status details, and client interceptors convert such status errors back
+// into hierarchical errors:
+//
+//	server := grpc.NewServer(
+//		grpc.UnaryInterceptor(hierrgrpc.UnaryServerInterceptor()),
+//		grpc.StreamInterceptor(hierrgrpc.StreamServerInterceptor()),
+//	)
+//
+//	conn, err := grpc.NewClient(
+//		target,
+//		grpc.WithUnaryInterceptor(hierrgrpc.UnaryClientInterceptor()),
+//		grpc.WithStreamInterceptor(hierrgrpc.StreamClientInterceptor()),
+//	)
+//
+// Status code is taken from context pair with hierr.CodeKey key, which
+// value is codes.Code, codes.Unknown is used otherwise.
+//
+// Error tree is stored as hierr.v1.Error message, described in error.proto
+// of hierrproto package, and can be converted manually using ToGRPCStatus()
+// and FromGRPCStatus().
+package hierrgrpc // import "github.com/reconquest/hierr-go/hierrgrpc"
+
+import (
+	"github.com/reconquest/hierr-go/hierrproto"
+)
+
+// MessageName is a full name of protobuf message, which is used as status
+// detail to store error tree.
+const MessageName = hierrproto.MessageName
@@ -0,0 +1,86 @@
+package hierrgrpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// UnaryServerInterceptor returns interceptor, which converts hierarchical
+// errors, returned by unary handlers, into gRPC status errors.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		request interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		response, err := handler(ctx, request)
+
+		return response, toStatus(err)
+	}
+}
+
+// StreamServerInterceptor returns interceptor, which converts hierarchical
+// errors, returned by stream handlers, into gRPC status errors.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		server interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return toStatus(handler(server, stream))
+	}
+}
+
+// UnaryClientInterceptor returns interceptor, which converts gRPC status
+// errors, containing error trees, into hierarchical errors. Converted errors
+// keep their statuses, so callers, which branch on status.Code(), are not
+// affected.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		request interface{},
+		response interface{},
+		conn *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		options ...grpc.CallOption,
+	) error {
+		return fromStatus(invoker(ctx, method, request, response, conn, options...))
+	}
+}
+
+// StreamClientInterceptor returns interceptor, which converts gRPC status
+// errors of streams, containing error trees, into hierarchical errors, which
+// keep their statuses, as UnaryClientInterceptor() does.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		conn *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		options ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		stream, err := streamer(ctx, desc, conn, method, options...)
+		if err != nil {
+			return nil, fromStatus(err)
+		}
+
+		return &clientStream{ClientStream: stream}, nil
+	}
+}
+
+type clientStream struct {
+	grpc.ClientStream
+}
+
+func (stream *clientStream) SendMsg(message interface{}) error {
+	return fromStatus(stream.ClientStream.SendMsg(message))
+}
+
+func (stream *clientStream) RecvMsg(message interface{}) error {
+	return fromStatus(stream.ClientStream.RecvMsg(message))
+}
@@ -0,0 +1,111 @@
+package hierrgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleUnaryServerInterceptor() {
+	server := UnaryServerInterceptor()
+	client := UnaryClientInterceptor()
+
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		return nil, hierr.Push(
+			"can't create job",
+			hierr.Errorf(errors.New("disk is full"), "can't write spool"),
+			hierr.Context("job", 42),
+			hierr.Context(hierr.CodeKey, codes.ResourceExhausted),
+		)
+	}
+
+	_, err := server(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+
+	fmt.Println(status.Code(err))
+
+	err = client(
+		context.Background(), "/jobs.Jobs/Create", nil, nil, nil,
+		func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+			return err
+		},
+	)
+
+	fmt.Println(status.Code(err))
+	fmt.Println(err)
+
+	// Output:
+	// ResourceExhausted
+	// ResourceExhausted
+	// can't create job
+	// ├─ can't write spool
+	// │  └─ disk is full
+	// │
+	// ├─ job
+	// │  └─ 42
+	// │
+	// └─ code
+	//    └─ ResourceExhausted
+}
+
+func ExampleStreamServerInterceptor() {
+	server := StreamServerInterceptor()
+
+	handler := func(interface{}, grpc.ServerStream) error {
+		return status.Error(codes.NotFound, "job not found")
+	}
+
+	err := server(nil, nil, &grpc.StreamServerInfo{}, handler)
+	fmt.Println(err)
+
+	err = server(nil, nil, &grpc.StreamServerInfo{}, func(interface{}, grpc.ServerStream) error {
+		return errors.New("connection reset")
+	})
+
+	fmt.Println(status.Code(err), fromStatus(err))
+
+	// Output:
+	// rpc error: code = NotFound desc = job not found
+	// Unknown connection reset
+}
+
+type failingStream struct {
+	grpc.ClientStream
+
+	err error
+}
+
+func (stream failingStream) RecvMsg(interface{}) error {
+	return stream.err
+}
+
+func ExampleStreamClientInterceptor() {
+	client := StreamClientInterceptor()
+
+	remote := ToGRPCStatus(
+		hierr.Errorf(errors.New("no such job"), "can't watch job"),
+		codes.NotFound,
+	).Err()
+
+	stream, _ := client(
+		context.Background(), &grpc.StreamDesc{}, nil, "/jobs.Jobs/Watch",
+		func(context.Context, *grpc.StreamDesc, *grpc.ClientConn, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+			return failingStream{err: remote}, nil
+		},
+	)
+
+	err := stream.RecvMsg(nil)
+
+	fmt.Println(status.Code(err))
+	fmt.Println(err)
+
+	// Output:
+	// NotFound
+	// can't watch job
+	// └─ no such job
+}
@@ -0,0 +1,124 @@
+package hierrgrpc
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/reconquest/hierr-go"
+	"github.com/reconquest/hierr-go/hierrproto"
+)
+
+// ToGRPCStatus converts error into gRPC status with given code, which
+// message is rendered error and details contain hierr.v1.Error message with
+// the whole error tree, including context pairs. Values of context pairs are
+// converted to strings. Status with codes.OK is returned for nil error.
+func ToGRPCStatus(err error, code codes.Code) *status.Status {
+	if err == nil {
+		return status.New(codes.OK, "")
+	}
+
+	result := status.New(code, err.Error())
+
+	detailed, detailErr := result.WithDetails(
+		protoadapt.MessageV1Of(hierrproto.ToProto(err)),
+	)
+	if detailErr != nil {
+		return result
+	}
+
+	return detailed
+}
+
+// FromGRPCStatus converts gRPC status into error. If status details contain
+// hierr.v1.Error message, then error tree is reconstructed from it,
+// otherwise status error is returned. Reconstructed error keeps the status,
+// which is returned by its GRPCStatus() method, so status.Code() and
+// status.FromError() still report code of the status. Nil is returned for
+// nil status and status with codes.OK.
+func FromGRPCStatus(st *status.Status) error {
+	if st == nil || st.Code() == codes.OK {
+		return nil
+	}
+
+	for _, detail := range st.Proto().GetDetails() {
+		if detail.MessageName() != MessageName {
+			continue
+		}
+
+		err, decodeErr := hierrproto.Unmarshal(detail.GetValue())
+		if decodeErr == nil {
+			return statusError{tree: err.(hierr.Error), status: st}
+		}
+	}
+
+	return st.Err()
+}
+
+// toStatus converts hierarchical error into gRPC status error with code,
+// which is taken from context pair with hierr.CodeKey key. Status errors and
+// nil are returned as is.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	code := codes.Unknown
+	for _, field := range hierr.AllFields(err) {
+		if value, ok := field.Value.(codes.Code); ok && field.Key == hierr.CodeKey {
+			code = value
+			break
+		}
+	}
+
+	return ToGRPCStatus(err, code).Err()
+}
+
+// fromStatus converts gRPC status error, which details contain error tree,
+// into hierarchical error. Other errors are returned as is.
+func fromStatus(err error) error {
+	result, ok := status.FromError(err)
+	if !ok || err == nil {
+		return err
+	}
+
+	return FromGRPCStatus(result)
+}
+
+// statusError is a hierarchical error, which is reconstructed from gRPC
+// status and keeps the status for status.FromError() and status.Code().
+type statusError struct {
+	tree   hierr.Error
+	status *status.Status
+}
+
+func (err statusError) Error() string {
+	return err.tree.Error()
+}
+
+func (err statusError) Format(state fmt.State, verb rune) {
+	err.tree.Format(state, verb)
+}
+
+func (err statusError) HierarchicalError() string {
+	return err.tree.HierarchicalError()
+}
+
+func (err statusError) GetNested() []hierr.NestedError {
+	return err.tree.GetNested()
+}
+
+func (err statusError) GetMessage() string {
+	return err.tree.GetMessage()
+}
+
+// GRPCStatus returns status, which error is reconstructed from.
+func (err statusError) GRPCStatus() *status.Status {
+	return err.status
+}
@@ -0,0 +1,55 @@
+package hierrgrpc
+
+import (
+	"errors"
+	"fmt"
+
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleToGRPCStatus() {
+	err := hierr.Context(
+		hierr.Errorf(errors.New("exit status 128"), "can't pull remote"),
+		hierr.Context("remote", "origin"),
+	)
+
+	st := ToGRPCStatus(err, codes.Unavailable)
+
+	wire, _ := proto.Marshal(st.Proto())
+
+	decoded := &spb.Status{}
+	_ = proto.Unmarshal(wire, decoded)
+
+	fmt.Println(st.Code())
+	fmt.Println(st.Proto().GetDetails()[0].MessageName())
+
+	reconstructed := FromGRPCStatus(status.FromProto(decoded))
+	fmt.Println(status.Code(reconstructed))
+	fmt.Println(reconstructed)
+
+	// Output:
+	// Unavailable
+	// hierr.v1.Error
+	// Unavailable
+	// can't pull remote
+	// ├─ exit status 128
+	// │
+	// └─ remote
+	//    └─ origin
+}
+
+func ExampleFromGRPCStatus() {
+	fmt.Println(FromGRPCStatus(status.New(codes.NotFound, "job not found")))
+	fmt.Println(FromGRPCStatus(status.New(codes.OK, "")))
+	fmt.Println(FromGRPCStatus(nil))
+
+	// Output:
+	// rpc error: code = NotFound desc = job not found
+	// <nil>
+	// <nil>
+}
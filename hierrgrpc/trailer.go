@@ -0,0 +1,72 @@
+package hierrgrpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/reconquest/hierr-go"
+	"github.com/reconquest/hierr-go/hierrproto"
+)
+
+// TrailerKey is a key of gRPC trailer, which contains packed error tree.
+// Key has -bin suffix, so gRPC transfers binary value as is.
+const TrailerKey = "hierr-error-bin"
+
+// SetTrailer packs error tree and stores it in trailer of current call, so
+// services, which don't use status details, still can propagate the whole
+// tree to callers. Nothing is set for nil error.
+func SetTrailer(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	data, packErr := hierrproto.Pack(err)
+	if packErr != nil {
+		return packErr
+	}
+
+	trailerErr := grpc.SetTrailer(ctx, metadata.Pairs(TrailerKey, string(data)))
+	if trailerErr != nil {
+		return hierr.Errorf(trailerErr, "can't set trailer %q", TrailerKey)
+	}
+
+	return nil
+}
+
+// FromTrailer reassembles error tree, propagated by SetTrailer(), and
+// returns it nested under local node with hierrproto.RemoteMessage message:
+//
+//	var trailer metadata.MD
+//
+//	_, err := client.Call(ctx, request, grpc.Trailer(&trailer))
+//	err = hierrgrpc.FromTrailer(trailer, err)
+//
+// If given error is gRPC status error, returned error keeps its status, so
+// status.Code() and status.FromError() still work. Given error is returned
+// as is, if it's nil or trailer doesn't contain valid error tree.
+func FromTrailer(trailer metadata.MD, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	values := trailer.Get(TrailerKey)
+	if len(values) == 0 {
+		return err
+	}
+
+	remote, unpackErr := hierrproto.Unpack([]byte(values[0]))
+	if unpackErr != nil {
+		return err
+	}
+
+	tree := hierrproto.Remote(remote)
+
+	if st, ok := status.FromError(err); ok {
+		return statusError{tree: tree.(hierr.Error), status: st}
+	}
+
+	return tree
+}
@@ -0,0 +1,55 @@
+package hierrgrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/reconquest/hierr-go"
+)
+
+type trailerStream struct {
+	grpc.ServerTransportStream
+
+	trailer metadata.MD
+}
+
+func (stream *trailerStream) SetTrailer(trailer metadata.MD) error {
+	stream.trailer = metadata.Join(stream.trailer, trailer)
+
+	return nil
+}
+
+func ExampleSetTrailer() {
+	stream := &trailerStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+
+	err := hierr.Errorf(errors.New("disk is full"), "can't write spool")
+	if setErr := SetTrailer(ctx, err); setErr != nil {
+		panic(setErr)
+	}
+
+	fmt.Println(FromTrailer(stream.trailer, errors.New("rpc error")))
+	fmt.Println(FromTrailer(metadata.MD{}, errors.New("rpc error")))
+	fmt.Println(FromTrailer(stream.trailer, nil))
+
+	remote := FromTrailer(
+		stream.trailer, status.Error(codes.ResourceExhausted, "disk is full"),
+	)
+	fmt.Println(status.Code(remote), hierr.String(remote) == hierr.String(
+		FromTrailer(stream.trailer, errors.New("rpc error")),
+	))
+
+	// Output:
+	// remote call failed
+	// └─ can't write spool
+	//    └─ disk is full
+	// rpc error
+	// <nil>
+	// ResourceExhausted true
+}
@@ -0,0 +1,6 @@
+// Package hierrhttp integrates hierarchical errors with net/http: it
+// provides transport, which reports failures of requests as hierarchical
+// errors with request context, middleware, which reports errors and panics
+// of handlers, rendering of errors as RFC 7807 problem details and
+// propagation of error trees from servers to clients.
+package hierrhttp // import "github.com/reconquest/hierr-go/hierrhttp"
@@ -0,0 +1,159 @@
+package hierrhttp
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// HandlerE is like http.HandlerFunc, but returns error, which is reported
+// by Middleware.
+type HandlerE func(writer http.ResponseWriter, request *http.Request) error
+
+// Middleware converts errors of handlers and panics into hierarchical
+// errors, logs them with request context and writes summary, which is safe
+// to be shown to client:
+//
+//	middleware := &hierrhttp.Middleware{}
+//
+//	http.Handle("/jobs", middleware.Handle(func(
+//		writer http.ResponseWriter,
+//		request *http.Request,
+//	) error {
+//		...
+//	}))
+//
+// Zero value of Middleware is ready to use.
+type Middleware struct {
+	// Log is called with failed request and error tree, which contains
+	// request context, log.Print() is used if it's nil.
+	Log func(request *http.Request, err error)
+
+	// Status returns status code of response for given error, StatusCode()
+	// is used if it's nil.
+	Status func(err error) int
+
+	// Summary returns body of response for given error and status code,
+	// status text is used if it's nil, so details of error are not exposed.
+	Summary func(err error, status int) string
+}
+
+// Handle returns handler, which calls given handler and reports returned
+// error or panic. Panics with http.ErrAbortHandler are not reported and are
+// propagated to net/http, which aborts response silently. Summary is not
+// written, if handler has already written response headers.
+func (middleware *Middleware) Handle(handler HandlerE) http.Handler {
+	return http.HandlerFunc(func(
+		writer http.ResponseWriter,
+		request *http.Request,
+	) {
+		tracker := &trackingWriter{ResponseWriter: writer}
+
+		err := middleware.serve(handler, tracker, request)
+		if err != nil {
+			middleware.report(tracker, request, err)
+		}
+	})
+}
+
+// Wrap returns handler, which calls given handler and reports its panics.
+func (middleware *Middleware) Wrap(handler http.Handler) http.Handler {
+	return middleware.Handle(func(
+		writer http.ResponseWriter,
+		request *http.Request,
+	) error {
+		handler.ServeHTTP(writer, request)
+		return nil
+	})
+}
+
+func (middleware *Middleware) serve(
+	handler HandlerE,
+	writer http.ResponseWriter,
+	request *http.Request,
+) (err error) {
+	panicked := true
+
+	defer func() {
+		if panicked && hierr.AnyIs(err, http.ErrAbortHandler) {
+			panic(http.ErrAbortHandler)
+		}
+	}()
+
+	defer hierr.Recover(&err, "handler panicked")
+
+	err = handler(writer, request)
+	panicked = false
+
+	return err
+}
+
+func (middleware *Middleware) report(
+	writer *trackingWriter,
+	request *http.Request,
+	err error,
+) {
+	status := StatusCode
+	if middleware.Status != nil {
+		status = middleware.Status
+	}
+
+	code := status(err)
+
+	tree := hierr.Push(
+		"can't handle request",
+		append([]hierr.NestedError{err}, requestContext(request)...)...,
+	)
+
+	if middleware.Log != nil {
+		middleware.Log(request, tree)
+	} else {
+		log.Print(tree)
+	}
+
+	if writer.written {
+		return
+	}
+
+	summary := http.StatusText(code)
+	if middleware.Summary != nil {
+		summary = middleware.Summary(err, code)
+	}
+
+	http.Error(writer, summary, code)
+}
+
+// trackingWriter is a http.ResponseWriter, which tracks whether response
+// headers are written.
+type trackingWriter struct {
+	http.ResponseWriter
+
+	written bool
+}
+
+func (writer *trackingWriter) WriteHeader(code int) {
+	writer.written = true
+	writer.ResponseWriter.WriteHeader(code)
+}
+
+func (writer *trackingWriter) Write(data []byte) (int, error) {
+	writer.written = true
+	return writer.ResponseWriter.Write(data)
+}
+
+func (writer *trackingWriter) Flush() {
+	writer.written = true
+	_ = http.NewResponseController(writer.ResponseWriter).Flush()
+}
+
+// Unwrap returns underlying writer for http.ResponseController.
+func (writer *trackingWriter) Unwrap() http.ResponseWriter {
+	return writer.ResponseWriter
+}
+
+// StatusCode returns status code for given error, as hierr.HTTPStatus()
+// does.
+func StatusCode(err error) int {
+	return hierr.HTTPStatus(err)
+}
@@ -0,0 +1,124 @@
+package hierrhttp
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleMiddleware() {
+	middleware := &Middleware{
+		Log: func(request *http.Request, err error) {
+			fmt.Println(err)
+		},
+	}
+
+	handler := middleware.Handle(func(
+		writer http.ResponseWriter,
+		request *http.Request,
+	) error {
+		switch request.URL.Query().Get("job") {
+		case "":
+			return hierr.Context(
+				errors.New("job is not specified"),
+				hierr.Context(StatusCodeKey, http.StatusBadRequest),
+			)
+
+		case "panic":
+			panic("unexpected state")
+		}
+
+		return nil
+	})
+
+	for _, target := range []string{"/jobs", "/jobs?job=panic", "/jobs?job=1"} {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(
+			recorder, httptest.NewRequest(http.MethodGet, target, nil),
+		)
+
+		fmt.Printf("%d %s\n", recorder.Code, recorder.Body)
+	}
+
+	// Output:
+	// can't handle request
+	// ├─ job is not specified
+	// │  └─ status code
+	// │     └─ 400
+	// │
+	// ├─ method
+	// │  └─ GET
+	// │
+	// └─ url
+	//    └─ /jobs
+	// 400 Bad Request
+	//
+	// can't handle request
+	// ├─ handler panicked
+	// │  └─ unexpected state
+	// │
+	// ├─ method
+	// │  └─ GET
+	// │
+	// └─ url
+	//    └─ /jobs?job=panic
+	// 500 Internal Server Error
+	//
+	// 200
+}
+
+func ExampleMiddleware_abort() {
+	middleware := &Middleware{
+		Log: func(request *http.Request, err error) {
+			fmt.Println(hierr.Flat(err))
+		},
+	}
+
+	handler := middleware.Handle(func(
+		writer http.ResponseWriter,
+		request *http.Request,
+	) error {
+		if request.URL.Query().Get("abort") != "" {
+			panic(http.ErrAbortHandler)
+		}
+
+		writer.WriteHeader(http.StatusAccepted)
+
+		return errors.New("connection reset")
+	})
+
+	func() {
+		defer func() {
+			fmt.Println(recover() == http.ErrAbortHandler)
+		}()
+
+		handler.ServeHTTP(
+			httptest.NewRecorder(),
+			httptest.NewRequest(http.MethodGet, "/jobs?abort=1", nil),
+		)
+	}()
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(
+		recorder, httptest.NewRequest(http.MethodGet, "/jobs", nil),
+	)
+
+	fmt.Printf("%d %q\n", recorder.Code, recorder.Body)
+
+	// Output:
+	// true
+	// can't handle request (method=GET, url=/jobs): connection reset
+	// 202 ""
+}
+
+func ExampleStatusCode() {
+	fmt.Println(StatusCode(errors.New("timeout")))
+	fmt.Println(StatusCode(hierr.WithHTTPStatus(errors.New("job not found"), 404)))
+
+	// Output:
+	// 500
+	// 404
+}
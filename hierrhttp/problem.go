@@ -0,0 +1,102 @@
+package hierrhttp
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// ProblemContentType is a media type of problem details, described by
+// RFC 7807.
+const ProblemContentType = "application/problem+json"
+
+// Problem represents problem details object, described by RFC 7807, which
+// has reasons and context of error as extension members:
+//
+//	{
+//	  "type": "about:blank",
+//	  "title": "can't create job",
+//	  "status": 507,
+//	  "detail": "disk is full",
+//	  "reasons": [
+//	    {
+//	      "message": "can't write spool",
+//	      "reasons": [{"message": "disk is full"}]
+//	    }
+//	  ],
+//	  "context": {"job": "42"}
+//	}
+type Problem struct {
+	Type     string            `json:"type,omitempty"`
+	Title    string            `json:"title"`
+	Status   int               `json:"status,omitempty"`
+	Detail   string            `json:"detail,omitempty"`
+	Instance string            `json:"instance,omitempty"`
+	Reasons  []ProblemReason   `json:"reasons,omitempty"`
+	Context  map[string]string `json:"context,omitempty"`
+}
+
+// ProblemReason represents nested reason of error in problem details.
+type ProblemReason struct {
+	Message string            `json:"message"`
+	Reasons []ProblemReason   `json:"reasons,omitempty"`
+	Context map[string]string `json:"context,omitempty"`
+}
+
+// ToProblem converts error into problem details, where title is top-level
+// message of error, detail contains messages of terminal reasons, status is
+// returned by StatusCode() and reasons and context mirror error tree.
+// Values of context pairs are converted to strings, if the same key is used
+// several times in one node, the last value is used.
+func ToProblem(err error) Problem {
+	root := toProblemReason(err)
+
+	details := []string{}
+	for _, leaf := range (hierr.Error{Nested: err}).Leaves() {
+		if message, _, _ := hierr.Decompose(leaf); message != root.Message {
+			details = append(details, message)
+		}
+	}
+
+	return Problem{
+		Type:    "about:blank",
+		Title:   root.Message,
+		Status:  StatusCode(err),
+		Detail:  strings.Join(details, "; "),
+		Reasons: root.Reasons,
+		Context: root.Context,
+	}
+}
+
+// WriteProblem writes problem details of error as response with
+// ProblemContentType content type.
+func WriteProblem(writer http.ResponseWriter, err error) error {
+	problem := ToProblem(err)
+
+	writer.Header().Set("Content-Type", ProblemContentType)
+	writer.WriteHeader(problem.Status)
+
+	return json.NewEncoder(writer).Encode(problem)
+}
+
+func toProblemReason(node hierr.NestedError) ProblemReason {
+	message, fields, reasons := hierr.Decompose(node)
+
+	reason := ProblemReason{Message: message}
+
+	for _, field := range fields {
+		if reason.Context == nil {
+			reason.Context = map[string]string{}
+		}
+
+		reason.Context[field.Key] = hierr.String(field.Value)
+	}
+
+	for _, nested := range reasons {
+		reason.Reasons = append(reason.Reasons, toProblemReason(nested))
+	}
+
+	return reason
+}
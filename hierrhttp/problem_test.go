@@ -0,0 +1,59 @@
+package hierrhttp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"os"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleToProblem() {
+	err := hierr.Push(
+		"can't create job",
+		hierr.Errorf(errors.New("disk is full"), "can't write spool"),
+		hierr.Context("job", 42),
+		hierr.Context(StatusCodeKey, 507),
+	)
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	encoder.Encode(ToProblem(err))
+
+	// Output:
+	// {
+	//   "type": "about:blank",
+	//   "title": "can't create job",
+	//   "status": 507,
+	//   "detail": "disk is full",
+	//   "reasons": [
+	//     {
+	//       "message": "can't write spool",
+	//       "reasons": [
+	//         {
+	//           "message": "disk is full"
+	//         }
+	//       ]
+	//     }
+	//   ],
+	//   "context": {
+	//     "job": "42",
+	//     "status code": "507"
+	//   }
+	// }
+}
+
+func ExampleWriteProblem() {
+	recorder := httptest.NewRecorder()
+
+	WriteProblem(recorder, errors.New("timeout"))
+
+	fmt.Println(recorder.Code, recorder.Header().Get("Content-Type"))
+	fmt.Print(recorder.Body)
+
+	// Output:
+	// 500 application/problem+json
+	// {"type":"about:blank","title":"timeout","status":500}
+}
@@ -0,0 +1,118 @@
+package hierrhttp
+
+import (
+	"encoding/base64"
+	"io"
+	"net/http"
+
+	"github.com/reconquest/hierr-go"
+	"github.com/reconquest/hierr-go/hierrproto"
+)
+
+const (
+	// ErrorHeader is a name of response header, which contains packed error
+	// tree, encoded using base64.
+	ErrorHeader = "X-Hierr-Error"
+
+	// EnvelopeContentType is a media type of response body, which contains
+	// packed error tree.
+	EnvelopeContentType = "application/vnd.hierr.error+protobuf"
+)
+
+// MaxEnvelopeSize is a maximum size of envelope body in bytes, which is read
+// by RemoteError(), larger envelopes are ignored. Size of decompressed tree
+// is limited by hierrproto.MaxUnpackedSize.
+var MaxEnvelopeSize = 64 << 10
+
+// SetErrorHeader packs error tree and stores it in ErrorHeader header, so
+// response body can be used for other purposes. Nothing is set for nil
+// error.
+func SetErrorHeader(header http.Header, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	data, packErr := hierrproto.Pack(err)
+	if packErr != nil {
+		return packErr
+	}
+
+	header.Set(ErrorHeader, base64.StdEncoding.EncodeToString(data))
+
+	return nil
+}
+
+// WriteEnvelope writes packed error tree as response body with
+// EnvelopeContentType content type and given status code.
+func WriteEnvelope(writer http.ResponseWriter, status int, err error) error {
+	data, packErr := hierrproto.Pack(err)
+	if packErr != nil {
+		return packErr
+	}
+
+	writer.Header().Set("Content-Type", EnvelopeContentType)
+	writer.WriteHeader(status)
+
+	if _, writeErr := writer.Write(data); writeErr != nil {
+		return hierr.Errorf(writeErr, "can't write error envelope")
+	}
+
+	return nil
+}
+
+// RemoteError reassembles error tree, propagated by SetErrorHeader() or
+// WriteEnvelope(), and returns it nested under local node with
+// hierrproto.RemoteMessage message and method, URL and attempt of request
+// and status code of response as context pairs. Body of response is read only if it's an envelope. Nil
+// is returned if response doesn't contain valid error tree.
+func RemoteError(response *http.Response) error {
+	remote := remoteTree(response)
+	if remote == nil {
+		return nil
+	}
+
+	nested := []hierr.NestedError{remote}
+	if response.Request != nil {
+		nested = append(nested, requestContext(response.Request)...)
+	}
+
+	if response.StatusCode != 0 {
+		nested = append(nested, hierr.Context(StatusCodeKey, response.StatusCode))
+	}
+
+	return hierr.Push(hierrproto.RemoteMessage, nested...)
+}
+
+func remoteTree(response *http.Response) error {
+	var data []byte
+
+	if header := response.Header.Get(ErrorHeader); header != "" {
+		decoded, err := base64.StdEncoding.DecodeString(header)
+		if err != nil {
+			return nil
+		}
+
+		data = decoded
+	} else {
+		if response.Header.Get("Content-Type") != EnvelopeContentType ||
+			response.Body == nil {
+			return nil
+		}
+
+		body, err := io.ReadAll(
+			io.LimitReader(response.Body, int64(MaxEnvelopeSize)+1),
+		)
+		if err != nil || len(body) > MaxEnvelopeSize {
+			return nil
+		}
+
+		data = body
+	}
+
+	remote, err := hierrproto.Unpack(data)
+	if err != nil {
+		return nil
+	}
+
+	return remote
+}
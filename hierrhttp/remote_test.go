@@ -0,0 +1,64 @@
+package hierrhttp
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleRemoteError() {
+	err := hierr.Errorf(errors.New("disk is full"), "can't write spool")
+
+	recorder := httptest.NewRecorder()
+	if writeErr := WriteEnvelope(recorder, http.StatusInsufficientStorage, err); writeErr != nil {
+		panic(writeErr)
+	}
+
+	response := recorder.Result()
+	response.Request = httptest.NewRequest("POST", "http://example.com/jobs", nil)
+
+	remote := RemoteError(response)
+
+	fmt.Println(remote)
+	fmt.Println(hierr.HTTPStatus(remote))
+
+	header := http.Header{}
+	if setErr := SetErrorHeader(header, err); setErr != nil {
+		panic(setErr)
+	}
+
+	fmt.Println(RemoteError(&http.Response{Header: header}))
+	fmt.Println(RemoteError(&http.Response{Header: http.Header{}}))
+
+	defer func(size int) { MaxEnvelopeSize = size }(MaxEnvelopeSize)
+
+	MaxEnvelopeSize = 16
+
+	recorder = httptest.NewRecorder()
+	_ = WriteEnvelope(recorder, http.StatusInsufficientStorage, err)
+
+	fmt.Println(RemoteError(recorder.Result()))
+
+	// Output:
+	// remote call failed
+	// ├─ can't write spool
+	// │  └─ disk is full
+	// │
+	// ├─ method
+	// │  └─ POST
+	// │
+	// ├─ url
+	// │  └─ http://example.com/jobs
+	// │
+	// └─ status code
+	//    └─ 507
+	// 507
+	// remote call failed
+	// └─ can't write spool
+	//    └─ disk is full
+	// <nil>
+	// <nil>
+}
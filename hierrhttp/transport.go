@@ -0,0 +1,146 @@
+package hierrhttp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/reconquest/hierr-go"
+)
+
+const (
+	// MethodKey is a key of context pair, which contains request method.
+	MethodKey = "method"
+
+	// URLKey is a key of context pair, which contains request URL without
+	// password.
+	URLKey = "url"
+
+	// AttemptKey is a key of context pair, which contains number of attempt,
+	// which is set by WithAttempt().
+	AttemptKey = "attempt"
+
+	// StatusCodeKey is a key of context pair, which contains status code of
+	// response.
+	StatusCodeKey = hierr.HTTPStatusKey
+)
+
+type attemptKey struct{}
+
+// WithAttempt returns copy of context, which carries number of attempt of
+// sending request, so it's reported by Transport.
+func WithAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptKey{}, attempt)
+}
+
+// Transport is a http.RoundTripper, which converts failures of underlying
+// transport into hierarchical errors with method, URL and attempt of
+// request as context pairs:
+//
+//	client := &http.Client{Transport: &hierrhttp.Transport{}}
+//
+// Chains of wrapped errors, like *url.Error and *net.OpError, are
+// decomposed into nested reasons, while original error is still returned by
+// Unwrap(), so errors.Is(err, context.DeadlineExceeded) keeps working.
+type Transport struct {
+	// Base is an underlying transport, http.DefaultTransport is used if it's
+	// nil.
+	Base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper interface.
+func (transport *Transport) RoundTrip(
+	request *http.Request,
+) (*http.Response, error) {
+	base := transport.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	response, err := base.RoundTrip(request)
+	if err != nil {
+		return nil, transportError{
+			tree: hierr.Push(
+				"can't send request",
+				append(
+					[]hierr.NestedError{Decompose(err)},
+					requestContext(request)...,
+				)...,
+			).(hierr.Error),
+			reason: err,
+		}
+	}
+
+	return response, nil
+}
+
+// transportError is a hierarchical error of failed request, which keeps
+// original error of underlying transport reachable by errors.Is() and
+// errors.As().
+type transportError struct {
+	tree   hierr.Error
+	reason error
+}
+
+func (err transportError) Error() string {
+	return err.tree.Error()
+}
+
+func (err transportError) Format(state fmt.State, verb rune) {
+	err.tree.Format(state, verb)
+}
+
+func (err transportError) HierarchicalError() string {
+	return err.tree.HierarchicalError()
+}
+
+func (err transportError) GetNested() []hierr.NestedError {
+	return err.tree.GetNested()
+}
+
+func (err transportError) GetMessage() string {
+	return err.tree.GetMessage()
+}
+
+func (err transportError) Unwrap() error {
+	return err.reason
+}
+
+// ResponseError returns hierarchical error with method, URL, attempt and
+// status code of request as context pairs, if response has status code 400
+// or greater, otherwise nil is returned.
+func ResponseError(response *http.Response) error {
+	if response.StatusCode < 400 {
+		return nil
+	}
+
+	nested := []hierr.NestedError{}
+	if response.Request != nil {
+		nested = requestContext(response.Request)
+	}
+
+	return hierr.Push(
+		fmt.Sprintf("unexpected response status %q", response.Status),
+		append(nested, hierr.Context(StatusCodeKey, response.StatusCode))...,
+	)
+}
+
+// Decompose converts chain of wrapped errors into hierarchy of nested
+// reasons, as hierr.FromError() does, so *url.Error and *net.OpError chains,
+// like "dial tcp: connect: connection refused", become nested nodes.
+func Decompose(err error) hierr.NestedError {
+	return hierr.FromError(err)
+}
+
+func requestContext(request *http.Request) []hierr.NestedError {
+	nested := []hierr.NestedError{
+		hierr.Context(MethodKey, request.Method),
+		hierr.Context(URLKey, request.URL.Redacted()),
+	}
+
+	if attempt, ok := request.Context().Value(attemptKey{}).(int); ok {
+		nested = append(nested, hierr.Context(AttemptKey, attempt))
+	}
+
+	return nested
+}
@@ -0,0 +1,177 @@
+// Package hierrjournal writes hierarchical errors into systemd journal
+// using native journal protocol.
+//
+// Top-level error message is written as MESSAGE field, every top-level
+// reason is written as HIERR_REASON_<N> field, containing rendered reason
+// tree, and context pairs of the whole tree are written as uppercase fields,
+// so they can be queried using journalctl:
+//
+//	journalctl HOST=node-a.localdomain
+//
+// Context keys, which would clash with well-known journal fields, like
+// MESSAGE or PRIORITY, or with fields of the package, are prefixed with
+// HIERR_, so "priority" key is written as HIERR_PRIORITY field.
+package hierrjournal // import "github.com/reconquest/hierr-go/hierrjournal"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// Priority represents journal message priority, which is the same as
+// syslog severity.
+type Priority int
+
+const (
+	PriorityEmergency Priority = iota
+	PriorityAlert
+	PriorityCritical
+	PriorityError
+	PriorityWarning
+	PriorityNotice
+	PriorityInfo
+	PriorityDebug
+)
+
+// SocketPath is path to journal socket, which is used by Send.
+var SocketPath = "/run/systemd/journal/socket"
+
+// fieldPrefix is a prefix of fields, which are written by the package, and
+// of context keys, which clash with reserved fields.
+const fieldPrefix = "HIERR_"
+
+// reservedFields are well-known journal fields, which have special meaning
+// for journald and journalctl.
+var reservedFields = map[string]bool{
+	"MESSAGE":            true,
+	"MESSAGE_ID":         true,
+	"PRIORITY":           true,
+	"CODE_FILE":          true,
+	"CODE_LINE":          true,
+	"CODE_FUNC":          true,
+	"ERRNO":              true,
+	"INVOCATION_ID":      true,
+	"USER_INVOCATION_ID": true,
+	"SYSLOG_FACILITY":    true,
+	"SYSLOG_IDENTIFIER":  true,
+	"SYSLOG_PID":         true,
+	"SYSLOG_TIMESTAMP":   true,
+	"SYSLOG_RAW":         true,
+	"DOCUMENTATION":      true,
+	"TID":                true,
+	"UNIT":               true,
+	"USER_UNIT":          true,
+}
+
+// Field represents single journal field.
+type Field struct {
+	// Name is uppercase journal field name.
+	Name string
+
+	// Value is a value of journal field.
+	Value string
+}
+
+// Fields returns journal fields for given error.
+func Fields(err error, priority Priority) []Field {
+	message, _, reasons := hierr.Decompose(err)
+
+	fields := []Field{
+		{"MESSAGE", message},
+		{"PRIORITY", fmt.Sprint(int(priority))},
+	}
+
+	for index, reason := range reasons {
+		fields = append(fields, Field{
+			Name:  fmt.Sprintf(fieldPrefix+"REASON_%d", index),
+			Value: hierr.String(reason),
+		})
+	}
+
+	for _, field := range hierr.AllFields(err) {
+		name := fieldName(field.Key)
+		if name == "" {
+			continue
+		}
+
+		fields = append(fields, Field{
+			Name:  name,
+			Value: hierr.String(field.Value),
+		})
+	}
+
+	return fields
+}
+
+// Encode returns datagram with given error encoded according to native
+// journal protocol.
+func Encode(err error, priority Priority) []byte {
+	buffer := bytes.Buffer{}
+
+	for _, field := range Fields(err, priority) {
+		if !strings.Contains(field.Value, "\n") {
+			fmt.Fprintf(&buffer, "%s=%s\n", field.Name, field.Value)
+			continue
+		}
+
+		buffer.WriteString(field.Name + "\n")
+		binary.Write(&buffer, binary.LittleEndian, uint64(len(field.Value)))
+		buffer.WriteString(field.Value + "\n")
+	}
+
+	return buffer.Bytes()
+}
+
+// Send writes given error into journal with specified priority.
+func Send(err error, priority Priority) error {
+	connection, dialErr := net.Dial("unixgram", SocketPath)
+	if dialErr != nil {
+		return hierr.Errorf(dialErr, "can't connect to journal socket")
+	}
+
+	defer connection.Close()
+
+	_, writeErr := connection.Write(Encode(err, priority))
+	if writeErr != nil {
+		return hierr.Errorf(writeErr, "can't write to journal socket")
+	}
+
+	return nil
+}
+
+// fieldName converts context key to journal field name, which can contain
+// only uppercase letters, digits and underscores and can't start with
+// underscore or digit. Names of reserved fields and names, which start with
+// fieldPrefix, are prefixed with fieldPrefix.
+func fieldName(key string) string {
+	name := strings.Map(func(symbol rune) rune {
+		switch {
+		case symbol >= 'a' && symbol <= 'z':
+			return symbol - 'a' + 'A'
+		case symbol >= 'A' && symbol <= 'Z', symbol >= '0' && symbol <= '9':
+			return symbol
+		default:
+			return '_'
+		}
+	}, key)
+
+	name = strings.TrimLeft(name, "_0123456789")
+	if name == "" {
+		return ""
+	}
+
+	if reservedFields[name] || strings.HasPrefix(name, fieldPrefix) {
+		name = fieldPrefix + name
+	}
+
+	if len(name) > 64 {
+		name = name[:64]
+	}
+
+	return name
+}
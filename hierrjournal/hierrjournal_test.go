@@ -0,0 +1,45 @@
+package hierrjournal
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleFields() {
+	err := hierr.Context(
+		hierr.Push(
+			"can't deploy",
+			hierr.Errorf(errors.New("exit status 128"), "can't pull remote"),
+			errors.New("disk is full"),
+		),
+		hierr.Context("host", "node-a.localdomain"),
+		hierr.Context("2nd-attempt", "yes"),
+		hierr.Context("priority", "high"),
+		hierr.Context("hierr_reason_0", "shadow"),
+	)
+
+	for _, field := range Fields(err, PriorityError) {
+		fmt.Printf("%s=%q\n", field.Name, field.Value)
+	}
+
+	// Output:
+	// MESSAGE="can't deploy"
+	// PRIORITY="3"
+	// HIERR_REASON_0="can't pull remote\n└─ exit status 128"
+	// HIERR_REASON_1="disk is full"
+	// HOST="node-a.localdomain"
+	// ND_ATTEMPT="yes"
+	// HIERR_PRIORITY="high"
+	// HIERR_HIERR_REASON_0="shadow"
+}
+
+func ExampleEncode() {
+	err := hierr.Errorf(errors.New("exit status 128"), "can't pull remote")
+
+	fmt.Printf("%q\n", Encode(err, PriorityWarning))
+
+	// Output:
+	// "MESSAGE=can't pull remote\nPRIORITY=4\nHIERR_REASON_0=exit status 128\n"
+}
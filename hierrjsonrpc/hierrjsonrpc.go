@@ -0,0 +1,115 @@
+// Package hierrjsonrpc converts hierarchical errors into JSON-RPC 2.0 error
+// objects and back. Error tree is serialized into data member of error
+// object, so clients receive hierarchical diagnostics:
+//
+//	{
+//	  "code": -32603,
+//	  "message": "can't create job",
+//	  "data": {
+//	    "message": "can't create job",
+//	    "children": [
+//	      {"reason": {"message": "disk is full"}},
+//	      {"key": "job", "value": "42"}
+//	    ]
+//	  }
+//	}
+//
+// Data member is hierr.SerialNode, which keeps order of reasons and context
+// pairs.
+package hierrjsonrpc // import "github.com/reconquest/hierr-go/hierrjsonrpc"
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// Error codes, which are defined by JSON-RPC 2.0 specification.
+const (
+	ParseError     = -32700
+	InvalidRequest = -32600
+	MethodNotFound = -32601
+	InvalidParams  = -32602
+	InternalError  = -32603
+)
+
+// Error represents JSON-RPC 2.0 error object.
+type Error struct {
+	Code    int               `json:"code"`
+	Message string            `json:"message"`
+	Data    *hierr.SerialNode `json:"data,omitempty"`
+}
+
+// Error returns message and code of error object.
+func (err *Error) Error() string {
+	return fmt.Sprintf("%s (code %d)", err.Message, err.Code)
+}
+
+// ToError converts error into JSON-RPC error object, which message is
+// top-level message of error and data contains the whole tree. Code is
+// taken from integer value of context pair with hierr.CodeKey key, which
+// can be also string, as it's decoded by FromError(), InternalError is used
+// otherwise. Nil is returned for nil error.
+func ToError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	code := InternalError
+	for _, field := range hierr.AllFields(err) {
+		if field.Key != hierr.CodeKey {
+			continue
+		}
+
+		if value, ok := parseCode(field.Value); ok {
+			code = value
+			break
+		}
+	}
+
+	data := hierr.ToSerial(err)
+
+	return &Error{
+		Code:    code,
+		Message: data.Message,
+		Data:    &data,
+	}
+}
+
+// FromError converts JSON-RPC error object into hierarchical error, which
+// is reconstructed from data member. If data is not set, hierarchical error
+// with message of error object is returned. Code is added as context pair
+// with hierr.CodeKey key, if it's not already present in the tree. Nil is
+// returned for nil error object.
+func FromError(object *Error) error {
+	if object == nil {
+		return nil
+	}
+
+	tree := hierr.Error{Message: object.Message}
+	if object.Data != nil {
+		tree = hierr.FromSerial(*object.Data)
+	}
+
+	if _, ok := tree.GetValue(hierr.CodeKey); !ok {
+		tree = tree.WithValue(hierr.CodeKey, object.Code)
+	}
+
+	return tree
+}
+
+// parseCode returns code, which is either integer or string, containing
+// integer.
+func parseCode(value interface{}) (int, bool) {
+	switch value := value.(type) {
+	case int:
+		return value, true
+
+	case string:
+		code, err := strconv.Atoi(value)
+		return code, err == nil
+	}
+
+	return 0, false
+}
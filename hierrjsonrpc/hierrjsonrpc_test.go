@@ -0,0 +1,64 @@
+package hierrjsonrpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleToError() {
+	err := hierr.Push(
+		"can't create job",
+		hierr.Errorf(errors.New("disk is full"), "can't write spool"),
+		hierr.Context("job", 42),
+	)
+
+	encoded, _ := json.Marshal(ToError(err))
+	fmt.Println(string(encoded))
+
+	var object Error
+	json.Unmarshal(encoded, &object)
+
+	fmt.Println(object.Error())
+	fmt.Println(FromError(&object))
+
+	// Output:
+	// {"code":-32603,"message":"can't create job","data":{"message":"can't create job","children":[{"reason":{"message":"can't write spool","children":[{"reason":{"message":"disk is full"}}]}},{"key":"job","value":"42"}]}}
+	// can't create job (code -32603)
+	// can't create job
+	// ├─ can't write spool
+	// │  └─ disk is full
+	// │
+	// ├─ job
+	// │  └─ 42
+	// │
+	// └─ code
+	//    └─ -32603
+}
+
+func ExampleFromError() {
+	fmt.Println(FromError(&Error{Code: MethodNotFound, Message: "method not found"}))
+	fmt.Println(FromError(nil))
+	fmt.Println(ToError(hierr.Build("invalid job").Code(InvalidParams).Err()).Code)
+
+	object := ToError(hierr.Build("invalid job").Code(InvalidParams).Err())
+	for hop := 0; hop < 2; hop++ {
+		encoded, _ := json.Marshal(object)
+
+		object = &Error{}
+		json.Unmarshal(encoded, object)
+		object = ToError(FromError(object))
+	}
+
+	fmt.Println(object.Code)
+
+	// Output:
+	// method not found
+	// └─ code
+	//    └─ -32601
+	// <nil>
+	// -32602
+	// -32602
+}
@@ -0,0 +1,140 @@
+// Package hierrk8s decomposes errors of Kubernetes API machinery into
+// hierarchical errors, so status errors and aggregates are displayed as
+// trees instead of one-line messages:
+//
+//	can't update deployment
+//	└─ Deployment.apps "web" is invalid
+//	   ├─ Invalid value: -1: must be greater than or equal to 0
+//	   │  ├─ field
+//	   │  │  └─ spec.replicas
+//	   │  │
+//	   │  └─ type
+//	   │     └─ FieldValueInvalid
+//	   │
+//	   ├─ reason
+//	   │  └─ Invalid
+//	   │
+//	   ├─ code
+//	   │  └─ 422
+//	   ...
+//
+// Use: hierr.Errorf(hierrk8s.Decompose(err), "can't update deployment")
+package hierrk8s // import "github.com/reconquest/hierr-go/hierrk8s"
+
+import (
+	"errors"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"github.com/reconquest/hierr-go"
+)
+
+const (
+	// ReasonKey is a key of context pair, which contains reason of status.
+	ReasonKey = "reason"
+
+	// KindKey, GroupKey and NameKey are keys of context pairs, which
+	// contain kind, group and name of resource, which status refers to.
+	KindKey  = "kind"
+	GroupKey = "group"
+	NameKey  = "name"
+
+	// FieldKey is a key of context pair, which contains field path of cause.
+	FieldKey = "field"
+
+	// TypeKey is a key of context pair, which contains type of cause.
+	TypeKey = "type"
+)
+
+// Decompose converts Kubernetes API errors into hierarchical errors. Errors,
+// which carry API status, like *StatusError, become nodes with message of
+// status, which reasons are causes of status, each with field path and type
+// as context pairs, and reason, code, kind, group and name of status as
+// context pairs. Aggregate errors become nodes with hierr.MergeMessage
+// message, which reasons are decomposed aggregated errors. Other errors are
+// returned as is.
+func Decompose(err error) error {
+	var aggregate utilerrors.Aggregate
+	if errors.As(err, &aggregate) {
+		reasons := []hierr.NestedError{}
+		for _, nested := range aggregate.Errors() {
+			reasons = append(reasons, Decompose(nested))
+		}
+
+		return hierr.Push(hierr.MergeMessage, reasons...)
+	}
+
+	var api apierrors.APIStatus
+	if !errors.As(err, &api) {
+		return err
+	}
+
+	status := api.Status()
+
+	nested := []hierr.NestedError{}
+
+	if details := status.Details; details != nil {
+		for _, cause := range details.Causes {
+			nodes := []hierr.NestedError{}
+			if cause.Field != "" {
+				nodes = append(nodes, hierr.Context(FieldKey, cause.Field))
+			}
+
+			if cause.Type != "" {
+				nodes = append(nodes, hierr.Context(TypeKey, string(cause.Type)))
+			}
+
+			nested = append(nested, hierr.Push(cause.Message, nodes...))
+		}
+	}
+
+	if status.Reason != "" {
+		nested = append(nested, hierr.Context(ReasonKey, string(status.Reason)))
+	}
+
+	if status.Code != 0 {
+		nested = append(nested, hierr.Context(hierr.CodeKey, int(status.Code)))
+	}
+
+	if details := status.Details; details != nil {
+		for _, pair := range []struct {
+			key   string
+			value string
+		}{
+			{KindKey, details.Kind},
+			{GroupKey, details.Group},
+			{NameKey, details.Name},
+		} {
+			if pair.value != "" {
+				nested = append(nested, hierr.Context(pair.key, pair.value))
+			}
+		}
+	}
+
+	return hierr.Push(trimCauses(status), nested...)
+}
+
+// trimCauses returns message of status without causes, which are appended
+// to the message by API machinery, since causes are reported as reasons.
+func trimCauses(status metav1.Status) string {
+	if status.Details == nil {
+		return status.Message
+	}
+
+	for _, cause := range status.Details.Causes {
+		for _, text := range []string{cause.Field, cause.Message} {
+			if text == "" {
+				continue
+			}
+
+			if index := strings.Index(status.Message, ": "+text); index > 0 {
+				return status.Message[:index]
+			}
+		}
+	}
+
+	return status.Message
+}
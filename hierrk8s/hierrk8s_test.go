@@ -0,0 +1,79 @@
+package hierrk8s
+
+import (
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleDecompose() {
+	err := apierrors.NewInvalid(
+		schema.GroupKind{Group: "apps", Kind: "Deployment"},
+		"web",
+		field.ErrorList{
+			field.Invalid(
+				field.NewPath("spec", "replicas"), -1,
+				"must be greater than or equal to 0",
+			),
+		},
+	)
+
+	fmt.Println(hierr.Errorf(Decompose(err), "can't update deployment"))
+
+	// Output:
+	// can't update deployment
+	// └─ Deployment.apps "web" is invalid
+	//    ├─ Invalid value: -1: must be greater than or equal to 0
+	//    │  ├─ field
+	//    │  │  └─ spec.replicas
+	//    │  │
+	//    │  └─ type
+	//    │     └─ FieldValueInvalid
+	//    │
+	//    ├─ reason
+	//    │  └─ Invalid
+	//    │
+	//    ├─ code
+	//    │  └─ 422
+	//    │
+	//    ├─ kind
+	//    │  └─ Deployment
+	//    │
+	//    ├─ group
+	//    │  └─ apps
+	//    │
+	//    └─ name
+	//       └─ web
+}
+
+func ExampleDecompose_aggregate() {
+	err := utilerrors.NewAggregate([]error{
+		apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, "web-1"),
+		errors.New("connection refused"),
+	})
+
+	fmt.Println(Decompose(err))
+
+	// Output:
+	// multiple errors occurred
+	// ├─ pods "web-1" not found
+	// │  ├─ reason
+	// │  │  └─ NotFound
+	// │  │
+	// │  ├─ code
+	// │  │  └─ 404
+	// │  │
+	// │  ├─ kind
+	// │  │  └─ pods
+	// │  │
+	// │  └─ name
+	// │     └─ web-1
+	// │
+	// └─ connection refused
+}
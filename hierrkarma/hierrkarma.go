@@ -0,0 +1,138 @@
+// Package hierrkarma provides compatibility layer between hierr and karma-go
+// (github.com/reconquest/karma-go) errors.
+//
+// Package doesn't import karma-go, instead karma errors are recognized by
+// their methods: GetMessage(), GetReasons() and GetContext(), where context
+// provides GetKeyValues() method, returning pairs with Key and Value fields.
+//
+// Karma errors, which are used as hierr reasons, can be converted into
+// equivalent hierr trees with reasons and context pairs preserved:
+//
+//	return hierr.Errorf(hierrkarma.Convert(err), "can't pull remote")
+//
+// Hierr errors can be used as karma reasons as is, because every hierr
+// error renders itself as a tree, which is indented by karma.
+package hierrkarma // import "github.com/reconquest/hierr-go/hierrkarma"
+
+import (
+	"reflect"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// IsKarma returns true if given reason looks like karma error.
+func IsKarma(reason hierr.NestedError) bool {
+	_, _, _, ok := decompose(reason)
+	return ok
+}
+
+// Convert converts karma errors into hierr errors, walking through whole
+// tree, so karma errors nested into hierr errors and vice versa are also
+// converted. Karma context pairs are converted into hierr context pairs,
+// which are placed after reasons. Any other reasons are returned as is.
+func Convert(reason hierr.NestedError) hierr.NestedError {
+	if message, reasons, fields, ok := decompose(reason); ok {
+		nested := []hierr.NestedError{}
+		for _, reason := range reasons {
+			nested = append(nested, Convert(reason))
+		}
+
+		for _, field := range fields {
+			nested = append(nested, hierr.Context(field.Key, field.Value))
+		}
+
+		return hierr.Error{
+			Message: message,
+			Nested:  nested,
+		}
+	}
+
+	err, ok := reason.(hierr.Error)
+	if !ok {
+		return reason
+	}
+
+	switch nested := err.Nested.(type) {
+	case nil:
+		return err
+
+	case []hierr.NestedError:
+		converted := []hierr.NestedError{}
+		for _, reason := range nested {
+			converted = append(converted, Convert(reason))
+		}
+
+		err.Nested = converted
+
+	default:
+		err.Nested = Convert(nested)
+	}
+
+	return err
+}
+
+func decompose(
+	reason hierr.NestedError,
+) (string, []hierr.NestedError, []hierr.Field, bool) {
+	if reason == nil {
+		return "", nil, nil, false
+	}
+
+	value := reflect.ValueOf(reason)
+
+	message, ok := call(value, "GetMessage")
+	if !ok || message.Kind() != reflect.String {
+		return "", nil, nil, false
+	}
+
+	nested, ok := call(value, "GetReasons")
+	if !ok || nested.Kind() != reflect.Slice {
+		return "", nil, nil, false
+	}
+
+	reasons := []hierr.NestedError{}
+	for index := 0; index < nested.Len(); index++ {
+		reasons = append(reasons, nested.Index(index).Interface())
+	}
+
+	fields := []hierr.Field{}
+
+	context, ok := call(value, "GetContext")
+	if ok && !(context.Kind() == reflect.Ptr && context.IsNil()) {
+		pairs, ok := call(context, "GetKeyValues")
+		if ok && pairs.Kind() == reflect.Slice {
+			for index := 0; index < pairs.Len(); index++ {
+				pair := reflect.Indirect(pairs.Index(index))
+				if pair.Kind() != reflect.Struct {
+					continue
+				}
+
+				key := pair.FieldByName("Key")
+				value := pair.FieldByName("Value")
+				if key.Kind() != reflect.String || !value.IsValid() {
+					continue
+				}
+
+				fields = append(fields, hierr.Field{
+					Key:   key.String(),
+					Value: value.Interface(),
+				})
+			}
+		}
+	}
+
+	return message.String(), reasons, fields, true
+}
+
+func call(value reflect.Value, name string) (reflect.Value, bool) {
+	method := value.MethodByName(name)
+	if !method.IsValid() {
+		return reflect.Value{}, false
+	}
+
+	if method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return reflect.Value{}, false
+	}
+
+	return method.Call(nil)[0], true
+}
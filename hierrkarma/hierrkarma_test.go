@@ -0,0 +1,108 @@
+package hierrkarma
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/reconquest/hierr-go"
+)
+
+type karmaReason interface{}
+
+type karmaKeyValue struct {
+	Key   string
+	Value interface{}
+}
+
+type karmaContext struct {
+	pairs []karmaKeyValue
+}
+
+func (context *karmaContext) GetKeyValues() []karmaKeyValue {
+	return context.pairs
+}
+
+type karma struct {
+	Message string
+	Reason  karmaReason
+	Context *karmaContext
+}
+
+func (karma karma) GetMessage() string {
+	return karma.Message
+}
+
+func (karma karma) GetReasons() []karmaReason {
+	if karma.Reason == nil {
+		return nil
+	}
+
+	return []karmaReason{karma.Reason}
+}
+
+func (karma karma) GetContext() *karmaContext {
+	return karma.Context
+}
+
+func (karma karma) Error() string {
+	if karma.Reason == nil {
+		return karma.Message
+	}
+
+	return karma.Message + "\n└─ " + strings.Replace(
+		hierr.String(karma.Reason), "\n", "\n   ", -1,
+	)
+}
+
+func ExampleConvert() {
+	testcases := []hierr.NestedError{
+		karma{
+			Message: "can't pull remote",
+			Reason:  errors.New("exit status 128"),
+			Context: &karmaContext{
+				pairs: []karmaKeyValue{{"remote", "origin"}},
+			},
+		},
+		hierr.Errorf(
+			karma{
+				Message: "can't run git fetch",
+				Reason:  hierr.Errorf(karma{Message: "broken pipe"}, "io error"),
+			},
+			"can't pull remote",
+		),
+		errors.New("plain error"),
+	}
+
+	for _, test := range testcases {
+		fmt.Println()
+		fmt.Println("{{{")
+		fmt.Println("karma:", IsKarma(test))
+		fmt.Println(hierr.String(Convert(test)))
+		fmt.Println("}}}")
+	}
+
+	// Output:
+	//
+	// {{{
+	// karma: true
+	// can't pull remote
+	// ├─ exit status 128
+	// │
+	// └─ remote
+	//    └─ origin
+	// }}}
+	//
+	// {{{
+	// karma: false
+	// can't pull remote
+	// └─ can't run git fetch
+	//    └─ io error
+	//       └─ broken pipe
+	// }}}
+	//
+	// {{{
+	// karma: false
+	// plain error
+	// }}}
+}
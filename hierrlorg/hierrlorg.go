@@ -0,0 +1,184 @@
+// Package hierrlorg provides helpers for logging hierarchical errors using
+// lorg logger (github.com/reconquest/lorg).
+//
+// Package doesn't import lorg directly, instead it uses Logger interface,
+// which is implemented by *lorg.Log.
+//
+//	printer := hierrlorg.NewPrinter(log)
+//	printer.SetFormat("${host}: ${error}")
+//
+//	printer.Log(hierrlorg.LevelError, err)
+package hierrlorg // import "github.com/reconquest/hierr-go/hierrlorg"
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// Level represents logging level, which order is the same as order of lorg
+// levels.
+type Level int
+
+const (
+	// LevelFatal will be logged using Fatalf.
+	LevelFatal Level = iota
+
+	// LevelError will be logged using Errorf.
+	LevelError
+
+	// LevelWarning will be logged using Warningf.
+	LevelWarning
+
+	// LevelInfo will be logged using Infof.
+	LevelInfo
+
+	// LevelDebug will be logged using Debugf.
+	LevelDebug
+
+	// LevelTrace will be logged using Tracef.
+	LevelTrace
+)
+
+// DefaultFormat is used by printer if no other format is specified.
+const DefaultFormat = `${error}`
+
+// Logger represents subset of lorg logger methods, which are used for
+// logging errors.
+type Logger interface {
+	Fatalf(format string, values ...interface{})
+	Errorf(format string, values ...interface{})
+	Warningf(format string, values ...interface{})
+	Infof(format string, values ...interface{})
+	Debugf(format string, values ...interface{})
+	Tracef(format string, values ...interface{})
+}
+
+// Printer logs hierarchical errors using underlying logger.
+type Printer struct {
+	logger Logger
+	format string
+}
+
+var placeholderRegexp = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// NewPrinter creates new printer, which will log errors to specified logger
+// using DefaultFormat.
+func NewPrinter(logger Logger) *Printer {
+	return &Printer{
+		logger: logger,
+		format: DefaultFormat,
+	}
+}
+
+// SetFormat sets format of logged message. Placeholder ${error} will be
+// replaced with rendered error tree, any other placeholder ${key} will be
+// replaced with value of top-level context pair with the same key, which
+// will be omitted from the rendered tree. Unknown placeholders are left as
+// is.
+//
+// Nested lines of rendered tree are indented to be aligned with the first
+// line of error.
+func (printer *Printer) SetFormat(format string) {
+	printer.format = format
+}
+
+// Log logs given error at specified level. Message is always passed to the
+// logger as argument of '%s' verb, so percent signs in error messages are
+// not interpreted. Nil error is not logged.
+func (printer *Printer) Log(level Level, err error) {
+	if err == nil {
+		return
+	}
+
+	logf := printer.logger.Errorf
+
+	switch level {
+	case LevelFatal:
+		logf = printer.logger.Fatalf
+	case LevelWarning:
+		logf = printer.logger.Warningf
+	case LevelInfo:
+		logf = printer.logger.Infof
+	case LevelDebug:
+		logf = printer.logger.Debugf
+	case LevelTrace:
+		logf = printer.logger.Tracef
+	}
+
+	logf("%s", printer.Format(err))
+}
+
+// Format returns message, which will be logged for given error.
+func (printer *Printer) Format(err error) string {
+	used := map[string]bool{}
+	for _, match := range placeholderRegexp.FindAllStringSubmatch(
+		printer.format, -1,
+	) {
+		used[match[1]] = true
+	}
+
+	message, fields, reasons := hierr.Decompose(err)
+
+	values := map[string]string{}
+	rest := []hierr.NestedError{}
+	for _, field := range fields {
+		if used[field.Key] {
+			values[field.Key] = hierr.String(field.Value)
+		} else {
+			rest = append(rest, hierr.Context(field.Key, field.Value))
+		}
+	}
+
+	tree := hierr.String(err)
+	if len(values) > 0 {
+		if root, ok := err.(hierr.Error); ok {
+			for key := range values {
+				root = root.WithoutValue(key)
+			}
+
+			tree = root.Error()
+		} else {
+			tree = hierr.Push(message, append(reasons, rest...)...).Error()
+		}
+	}
+
+	lines := strings.Split(printer.format, "\n")
+	for index, line := range lines {
+		lines[index] = placeholderRegexp.ReplaceAllStringFunc(
+			line,
+			func(placeholder string) string {
+				key := placeholder[2 : len(placeholder)-1]
+				if key == "error" {
+					return placeholder
+				}
+
+				if value, ok := values[key]; ok {
+					return value
+				}
+
+				return placeholder
+			},
+		)
+
+		position := strings.Index(lines[index], "${error}")
+		if position < 0 {
+			continue
+		}
+
+		indentation := strings.Repeat(
+			" ", utf8.RuneCountInString(lines[index][:position]),
+		)
+
+		lines[index] = strings.Replace(
+			lines[index],
+			"${error}",
+			strings.Replace(tree, "\n", "\n"+indentation, -1),
+			-1,
+		)
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,112 @@
+package hierrlorg
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/reconquest/hierr-go"
+)
+
+type logger struct{}
+
+func (logger) log(level string, format string, values ...interface{}) {
+	fmt.Println("{{{")
+	fmt.Printf("["+level+"] "+format+"\n", values...)
+	fmt.Println("}}}")
+}
+
+func (logger logger) Fatalf(format string, values ...interface{}) {
+	logger.log("FATAL", format, values...)
+}
+
+func (logger logger) Errorf(format string, values ...interface{}) {
+	logger.log("ERROR", format, values...)
+}
+
+func (logger logger) Warningf(format string, values ...interface{}) {
+	logger.log("WARNING", format, values...)
+}
+
+func (logger logger) Infof(format string, values ...interface{}) {
+	logger.log("INFO", format, values...)
+}
+
+func (logger logger) Debugf(format string, values ...interface{}) {
+	logger.log("DEBUG", format, values...)
+}
+
+func (logger logger) Tracef(format string, values ...interface{}) {
+	logger.log("TRACE", format, values...)
+}
+
+func ExamplePrinter() {
+	err := hierr.Context(
+		hierr.Errorf(
+			errors.New("exit status 128"),
+			"can't pull remote 'origin' (100%% broken)",
+		),
+		hierr.Context("host", "node-a"),
+		hierr.Context("attempt", "3"),
+	)
+
+	printer := NewPrinter(logger{})
+	printer.Log(LevelError, err)
+
+	printer.SetFormat("${host}: ${error}")
+	printer.Log(LevelWarning, err)
+
+	printer.SetFormat("${host}: ${unknown}\n  ${error}")
+	printer.Log(LevelDebug, err)
+
+	printer.Log(LevelInfo, nil)
+
+	// Output:
+	// {{{
+	// [ERROR] can't pull remote 'origin' (100% broken)
+	// ├─ exit status 128
+	// │
+	// ├─ host
+	// │  └─ node-a
+	// │
+	// └─ attempt
+	//    └─ 3
+	// }}}
+	// {{{
+	// [WARNING] node-a: can't pull remote 'origin' (100% broken)
+	//         ├─ exit status 128
+	//         │
+	//         └─ attempt
+	//            └─ 3
+	// }}}
+	// {{{
+	// [DEBUG] node-a: ${unknown}
+	//   can't pull remote 'origin' (100% broken)
+	//   ├─ exit status 128
+	//   │
+	//   └─ attempt
+	//      └─ 3
+	// }}}
+}
+
+func ExamplePrinter_Format() {
+	err := hierr.Push(
+		"can't deploy",
+		hierr.Context("attempt", "3"),
+		hierr.Context("host", "node-a"),
+		errors.New("timeout"),
+	).(hierr.Error)
+
+	err.ID = "a1b2"
+
+	printer := NewPrinter(logger{})
+	printer.SetFormat("${host}: ${error}")
+
+	fmt.Println(printer.Format(err))
+
+	// Output:
+	// node-a: can't deploy [error ref: a1b2]
+	//         ├─ attempt
+	//         │  └─ 3
+	//         │
+	//         └─ timeout
+}
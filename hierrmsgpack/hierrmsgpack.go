@@ -0,0 +1,30 @@
+// Package hierrmsgpack encodes hierarchical errors into MessagePack and
+// decodes them back, so error trees can be embedded into binary telemetry
+// pipelines, where JSON overhead matters.
+//
+// Error trees are encoded in form of hierr.SerialNode, which nodes are
+// encoded as arrays of message and children, values of context pairs are
+// converted to strings.
+package hierrmsgpack // import "github.com/reconquest/hierr-go/hierrmsgpack"
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// Marshal encodes the whole error tree, including context pairs, into
+// MessagePack.
+func Marshal(err error) ([]byte, error) {
+	return msgpack.Marshal(hierr.ToSerial(err))
+}
+
+// Unmarshal decodes error tree, encoded by Marshal().
+func Unmarshal(data []byte) (error, error) {
+	node := hierr.SerialNode{}
+	if err := msgpack.Unmarshal(data, &node); err != nil {
+		return nil, hierr.Errorf(err, "can't decode error tree")
+	}
+
+	return hierr.FromSerial(node), nil
+}
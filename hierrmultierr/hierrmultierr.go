@@ -0,0 +1,40 @@
+// Package hierrmultierr converts hierarchical errors into multi-errors of
+// go.uber.org/multierr, so trees can be passed to code, which inspects
+// errors using multierr.Errors().
+//
+// Conversion in the opposite direction is not required: multi-errors of
+// go.uber.org/multierr and github.com/hashicorp/go-multierror, which are
+// used as reasons, are expanded into sibling reasons by hierr itself.
+package hierrmultierr // import "github.com/reconquest/hierr-go/hierrmultierr"
+
+import (
+	"errors"
+
+	"go.uber.org/multierr"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// ToMultierror returns multi-error, which contains reasons of top-level
+// error as separate errors, so every reason is reported with its own
+// subtree. Reasons, which are not errors, are converted to errors, context
+// pairs of top-level error are not included. If error has no reasons, error
+// itself is returned.
+func ToMultierror(err error) error {
+	_, _, reasons := hierr.Decompose(err)
+	if len(reasons) == 0 {
+		return err
+	}
+
+	errs := []error{}
+	for _, reason := range reasons {
+		nested, ok := reason.(error)
+		if !ok {
+			nested = errors.New(hierr.String(reason))
+		}
+
+		errs = append(errs, nested)
+	}
+
+	return multierr.Combine(errs...)
+}
@@ -0,0 +1,45 @@
+package hierrmultierr
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/multierr"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleToMultierror() {
+	refused := errors.New("connection refused")
+
+	err := ToMultierror(hierr.Push(
+		"can't deploy",
+		hierr.Errorf(refused, "can't connect to node-a"),
+		errors.New("disk is full"),
+		hierr.Context("attempt", 1),
+	))
+
+	for _, err := range multierr.Errors(err) {
+		fmt.Println(err)
+	}
+
+	fmt.Println(ToMultierror(refused) == refused)
+
+	// Output:
+	// can't connect to node-a
+	// └─ connection refused
+	// disk is full
+	// true
+}
+
+func Example_expand() {
+	fmt.Println(hierr.Errorf(
+		multierr.Combine(errors.New("connection refused"), errors.New("disk is full")),
+		"can't deploy",
+	))
+
+	// Output:
+	// can't deploy
+	// ├─ connection refused
+	// └─ disk is full
+}
@@ -0,0 +1,80 @@
+package hierrprometheus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// Labels of counter.
+const (
+	// FingerprintLabel is a label, which contains fingerprint of error tree,
+	// which is returned by hierr.Fingerprint().
+	FingerprintLabel = "fingerprint"
+
+	// CodeLabel is a label, which contains value of context pair with
+	// hierr.CodeKey key, or empty string, if there is no such pair.
+	CodeLabel = "code"
+
+	// RootCauseLabel is a label, which contains normalized message of the
+	// first terminal reason of error.
+	RootCauseLabel = "root_cause"
+)
+
+// Counter is a Prometheus counter of errors, which implements
+// prometheus.Collector interface.
+type Counter struct {
+	counter *prometheus.CounterVec
+}
+
+// NewCounter returns counter with given options and FingerprintLabel,
+// CodeLabel and RootCauseLabel labels.
+func NewCounter(options prometheus.CounterOpts) *Counter {
+	return &Counter{
+		counter: prometheus.NewCounterVec(
+			options,
+			[]string{FingerprintLabel, CodeLabel, RootCauseLabel},
+		),
+	}
+}
+
+// Report increments counter with labels of given error, it can be
+// registered as reporter using hierr.RegisterReporter(). Nil errors are
+// ignored.
+func (counter *Counter) Report(err error) {
+	if err == nil {
+		return
+	}
+
+	counter.counter.WithLabelValues(Labels(err)...).Inc()
+}
+
+// Describe implements prometheus.Collector interface.
+func (counter *Counter) Describe(descriptions chan<- *prometheus.Desc) {
+	counter.counter.Describe(descriptions)
+}
+
+// Collect implements prometheus.Collector interface.
+func (counter *Counter) Collect(metrics chan<- prometheus.Metric) {
+	counter.counter.Collect(metrics)
+}
+
+// Labels returns values of FingerprintLabel, CodeLabel and RootCauseLabel
+// labels of given error. Root cause is the first leaf message, which is
+// normalized by hierr.NormalizeMessage(), so numbers, addresses and IDs
+// don't make cardinality of the label unbounded.
+func Labels(err error) []string {
+	code := ""
+	for _, field := range hierr.AllFields(err) {
+		if field.Key == hierr.CodeKey {
+			code = hierr.String(field.Value)
+			break
+		}
+	}
+
+	message, _, _ := hierr.Decompose(hierr.Error{Nested: err}.Leaves()[0])
+
+	return []string{
+		hierr.Fingerprint(err), code, hierr.NormalizeMessage(message),
+	}
+}
@@ -0,0 +1,52 @@
+package hierrprometheus
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleCounter() {
+	counter := NewCounter(prometheus.CounterOpts{
+		Name: "errors_total",
+		Help: "Number of reported errors.",
+	})
+
+	hierr.RegisterReporter(counter.Report)
+
+	for _, host := range []string{"node-a", "node-b"} {
+		hierr.Report(hierr.Push(
+			"can't deploy",
+			errors.New("connection refused"),
+			hierr.Context("host", host),
+			hierr.Context(hierr.CodeKey, 503),
+		))
+	}
+
+	hierr.Report(errors.New("disk is full"))
+
+	fmt.Println(testutil.CollectAndCount(counter))
+
+	labels := Labels(hierr.Push(
+		"can't deploy",
+		errors.New("connection refused"),
+		hierr.Context("host", "node-c"),
+		hierr.Context(hierr.CodeKey, 503),
+	))
+
+	fmt.Println(labels[1], labels[2])
+	fmt.Println(testutil.ToFloat64(counter.counter.WithLabelValues(labels...)))
+
+	labels = Labels(hierr.Errorf(errors.New("dial tcp 10.0.0.7:5432: refused"), "can't connect"))
+	fmt.Println(labels[2])
+
+	// Output:
+	// 2
+	// 503 connection refused
+	// 2
+	// dial tcp <n>.<n>.<n>.<n>:<n>: refused
+}
@@ -0,0 +1,15 @@
+// Package hierrprometheus counts hierarchical errors using Prometheus
+// counter, labeled by fingerprint, code and root cause of error, so teams
+// get error-rate dashboards without separate instrumentation:
+//
+//	counter := hierrprometheus.NewCounter(prometheus.CounterOpts{
+//		Name: "errors_total",
+//		Help: "Number of reported errors.",
+//	})
+//
+//	prometheus.MustRegister(counter)
+//	hierr.RegisterReporter(counter.Report)
+//
+// Every error, passed to hierr.Report(), is counted then, and every created
+// error as well, if hierr.ReportCreated is set.
+package hierrprometheus // import "github.com/reconquest/hierr-go/hierrprometheus"
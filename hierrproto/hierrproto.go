@@ -0,0 +1,12 @@
+// Package hierrproto converts hierarchical errors into protobuf messages and
+// back, so error trees can travel in gRPC status details, Kafka messages and
+// other proto-first systems.
+//
+// Error tree is represented as hierr.v1.Error message, which is described in
+// error.proto, and which descriptor is built at runtime, so code generation
+// is not required:
+//
+//	data, err := hierrproto.Marshal(err)
+//
+//	err, decodeErr := hierrproto.Unmarshal(data)
+package hierrproto // import "github.com/reconquest/hierr-go/hierrproto"
@@ -0,0 +1,215 @@
+package hierrproto
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// MessageName is a full name of protobuf message, which represents node of
+// error tree. Message is described in error.proto file.
+const MessageName = "hierr.v1.Error"
+
+var (
+	errorDescriptor protoreflect.MessageDescriptor
+	childDescriptor protoreflect.MessageDescriptor
+)
+
+// init builds and registers descriptors of messages, described in
+// error.proto, so code generation is not required.
+func init() {
+	field := func(
+		name string,
+		number int32,
+		kind descriptorpb.FieldDescriptorProto_Type,
+		label descriptorpb.FieldDescriptorProto_Label,
+		message string,
+	) *descriptorpb.FieldDescriptorProto {
+		descriptor := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Type:     kind.Enum(),
+			Label:    label.Enum(),
+		}
+
+		if message != "" {
+			descriptor.TypeName = proto.String(message)
+		}
+
+		return descriptor
+	}
+
+	var (
+		optional = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+		repeated = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+		text     = descriptorpb.FieldDescriptorProto_TYPE_STRING
+		message  = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	)
+
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("hierr/v1/error.proto"),
+		Package: proto.String("hierr.v1"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Error"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("message", 1, text, optional, ""),
+					field("children", 4, message, repeated, ".hierr.v1.Child"),
+				},
+				ReservedRange: []*descriptorpb.DescriptorProto_ReservedRange{
+					{Start: proto.Int32(2), End: proto.Int32(4)},
+				},
+			},
+			{
+				Name: proto.String("Child"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("key", 1, text, optional, ""),
+					field("value", 2, text, optional, ""),
+					field("reason", 3, message, optional, ".hierr.v1.Error"),
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	errorDescriptor = file.Messages().ByName("Error")
+	childDescriptor = file.Messages().ByName("Child")
+
+	if _, err := protoregistry.GlobalTypes.FindMessageByName(
+		MessageName,
+	); err != nil {
+		protoregistry.GlobalTypes.RegisterMessage(
+			dynamicpb.NewMessageType(errorDescriptor),
+		)
+	}
+}
+
+// ToProto converts the whole error tree, including context pairs, into
+// hierr.v1.Error message. Values of context pairs are converted to strings.
+// Nil is returned for nil error.
+func ToProto(err error) proto.Message {
+	if err == nil {
+		return nil
+	}
+
+	return encode(hierr.ToSerial(err))
+}
+
+// FromProto converts hierr.v1.Error message into error tree. Message can be
+// either dynamic message, returned by ToProto(), or message, generated from
+// error.proto.
+func FromProto(message proto.Message) (error, error) {
+	if message == nil {
+		return nil, nil
+	}
+
+	encoded := message.ProtoReflect()
+	if name := encoded.Descriptor().FullName(); name != MessageName {
+		return nil, fmt.Errorf(
+			"can't convert message %q into error: %s message expected",
+			name, MessageName,
+		)
+	}
+
+	return hierr.FromSerial(decode(encoded)), nil
+}
+
+// Marshal encodes error tree into wire format of hierr.v1.Error message, so
+// it can be sent to proto-first systems, like message queues.
+func Marshal(err error) ([]byte, error) {
+	return proto.Marshal(encode(hierr.ToSerial(err)))
+}
+
+// Unmarshal decodes error tree from wire format of hierr.v1.Error message.
+func Unmarshal(data []byte) (error, error) {
+	encoded := NewMessage()
+	if err := proto.Unmarshal(data, encoded); err != nil {
+		return nil, hierr.Errorf(err, "can't decode error tree")
+	}
+
+	return hierr.FromSerial(decode(encoded)), nil
+}
+
+// NewMessage returns new empty hierr.v1.Error message, which can be used as
+// destination for unmarshaling.
+func NewMessage() *dynamicpb.Message {
+	return dynamicpb.NewMessage(errorDescriptor)
+}
+
+// encode converts serialized error tree into hierr.v1.Error message.
+func encode(node hierr.SerialNode) *dynamicpb.Message {
+	encoded := dynamicpb.NewMessage(errorDescriptor)
+	encoded.Set(
+		errorDescriptor.Fields().ByName("message"),
+		protoreflect.ValueOfString(node.Message),
+	)
+
+	children := encoded.Mutable(errorDescriptor.Fields().ByName("children")).List()
+	for _, child := range node.Children {
+		fields := childDescriptor.Fields()
+
+		encodedChild := dynamicpb.NewMessage(childDescriptor)
+		if child.Reason != nil {
+			encodedChild.Set(
+				fields.ByName("reason"),
+				protoreflect.ValueOfMessage(encode(*child.Reason)),
+			)
+		} else {
+			encodedChild.Set(
+				fields.ByName("key"), protoreflect.ValueOfString(child.Key),
+			)
+			encodedChild.Set(
+				fields.ByName("value"), protoreflect.ValueOfString(child.Value),
+			)
+		}
+
+		children.Append(protoreflect.ValueOfMessage(encodedChild))
+	}
+
+	return encoded
+}
+
+// decode converts hierr.v1.Error message into serialized error tree.
+// Fields are looked up by descriptor of given message, so generated
+// messages are supported as well.
+func decode(encoded protoreflect.Message) hierr.SerialNode {
+	fields := encoded.Descriptor().Fields()
+
+	node := hierr.SerialNode{
+		Message: encoded.Get(fields.ByName("message")).String(),
+	}
+
+	children := encoded.Get(fields.ByName("children")).List()
+	for index := 0; index < children.Len(); index++ {
+		child := children.Get(index).Message()
+		childFields := child.Descriptor().Fields()
+
+		if reason := childFields.ByName("reason"); child.Has(reason) {
+			decoded := decode(child.Get(reason).Message())
+
+			node.Children = append(node.Children, hierr.SerialChild{
+				Reason: &decoded,
+			})
+
+			continue
+		}
+
+		node.Children = append(node.Children, hierr.SerialChild{
+			Key:   child.Get(childFields.ByName("key")).String(),
+			Value: child.Get(childFields.ByName("value")).String(),
+		})
+	}
+
+	return node
+}
@@ -0,0 +1,66 @@
+package hierrproto
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleToProto() {
+	err := hierr.Push(
+		"can't create job",
+		hierr.Errorf(errors.New("disk is full"), "can't write spool"),
+		hierr.Context("job", 42),
+	)
+
+	message := ToProto(err)
+	fmt.Println(message.ProtoReflect().Descriptor().FullName())
+
+	decoded, decodeErr := FromProto(message)
+	if decodeErr != nil {
+		panic(decodeErr)
+	}
+
+	fmt.Println(decoded)
+
+	_, decodeErr = FromProto(&durationpb.Duration{})
+	fmt.Println(decodeErr)
+
+	// Output:
+	// hierr.v1.Error
+	// can't create job
+	// ├─ can't write spool
+	// │  └─ disk is full
+	// │
+	// └─ job
+	//    └─ 42
+	// can't convert message "google.protobuf.Duration" into error: hierr.v1.Error message expected
+}
+
+func ExampleMarshal() {
+	data, err := Marshal(hierr.Push(
+		"can't write",
+		hierr.Context("path", "/var/spool"),
+		errors.New("disk is full"),
+	))
+	if err != nil {
+		panic(err)
+	}
+
+	decoded, err := Unmarshal(data)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(decoded)
+
+	// Output:
+	// can't write
+	// ├─ path
+	// │  └─ /var/spool
+	// │
+	// └─ disk is full
+}
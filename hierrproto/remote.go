@@ -0,0 +1,73 @@
+package hierrproto
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// RemoteMessage is a message of local error, under which error tree, which
+// is received from another process, is nested by Remote().
+var RemoteMessage = "remote call failed"
+
+// MaxUnpackedSize is a maximum size of decompressed error tree in bytes,
+// which is accepted by Unpack(), so peers can't exhaust memory by sending
+// decompression bombs.
+var MaxUnpackedSize = 1 << 20
+
+// Pack encodes error tree into wire format of hierr.v1.Error message and
+// compresses it using gzip, so it can be propagated between processes in
+// size-limited metadata, like gRPC trailers or HTTP headers.
+func Pack(err error) ([]byte, error) {
+	data, marshalErr := Marshal(err)
+	if marshalErr != nil {
+		return nil, hierr.Errorf(marshalErr, "can't encode error tree")
+	}
+
+	buffer := bytes.Buffer{}
+
+	writer := gzip.NewWriter(&buffer)
+	if _, writeErr := writer.Write(data); writeErr != nil {
+		return nil, hierr.Errorf(writeErr, "can't compress error tree")
+	}
+
+	if closeErr := writer.Close(); closeErr != nil {
+		return nil, hierr.Errorf(closeErr, "can't compress error tree")
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// Unpack decompresses and decodes error tree, packed by Pack(). Error is
+// returned if decompressed tree is larger than MaxUnpackedSize bytes.
+func Unpack(data []byte) (error, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, hierr.Errorf(err, "can't decompress error tree")
+	}
+
+	decompressed, err := io.ReadAll(
+		io.LimitReader(reader, int64(MaxUnpackedSize)+1),
+	)
+	if err != nil {
+		return nil, hierr.Errorf(err, "can't decompress error tree")
+	}
+
+	if len(decompressed) > MaxUnpackedSize {
+		return nil, hierr.Errorf(
+			fmt.Sprintf("tree exceeds %d bytes", MaxUnpackedSize),
+			"can't decompress error tree",
+		)
+	}
+
+	return Unmarshal(decompressed)
+}
+
+// Remote returns local error with RemoteMessage message, which has error
+// tree, received from another process, as nested reason.
+func Remote(remote error) error {
+	return hierr.New(remote, RemoteMessage)
+}
@@ -0,0 +1,42 @@
+package hierrproto
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExamplePack() {
+	data, err := Pack(hierr.Errorf(errors.New("disk is full"), "can't write"))
+	if err != nil {
+		panic(err)
+	}
+
+	remote, err := Unpack(data)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(Remote(remote))
+
+	_, err = Unpack([]byte("garbage"))
+	fmt.Println(err)
+
+	bomb, _ := Pack(hierr.Errorf(
+		errors.New(strings.Repeat("0", 2*MaxUnpackedSize)), "can't write",
+	))
+
+	_, err = Unpack(bomb)
+	fmt.Println(err)
+
+	// Output:
+	// remote call failed
+	// └─ can't write
+	//    └─ disk is full
+	// can't decompress error tree
+	// └─ unexpected EOF
+	// can't decompress error tree
+	// └─ tree exceeds 1048576 bytes
+}
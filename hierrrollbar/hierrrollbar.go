@@ -0,0 +1,164 @@
+// Package hierrrollbar converts hierarchical errors into Rollbar item API
+// payloads.
+//
+// Every node of error tree is reported as separate trace of trace chain,
+// starting from top-level one, followed by its causes, and context pairs are
+// reported as custom data. Call stacks, captured by hierr.ErrorfStack(),
+// are reported as frames of traces, which correspond to nodes, which
+// captured them.
+package hierrrollbar // import "github.com/reconquest/hierr-go/hierrrollbar"
+
+import (
+	"reflect"
+	"runtime"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// Item represents Rollbar item API request body.
+type Item struct {
+	AccessToken string `json:"access_token"`
+	Data        Data   `json:"data"`
+}
+
+// Data represents reported occurrence.
+type Data struct {
+	Environment string                 `json:"environment"`
+	Level       string                 `json:"level"`
+	Platform    string                 `json:"platform"`
+	Language    string                 `json:"language"`
+	Title       string                 `json:"title,omitempty"`
+	Body        Body                   `json:"body"`
+	Custom      map[string]interface{} `json:"custom,omitempty"`
+}
+
+// Body contains trace chain of reported error.
+type Body struct {
+	TraceChain []Trace `json:"trace_chain"`
+}
+
+// Trace represents single exception of trace chain.
+type Trace struct {
+	Frames    []Frame   `json:"frames"`
+	Exception Exception `json:"exception"`
+}
+
+// Exception describes exception of trace.
+type Exception struct {
+	Class   string `json:"class"`
+	Message string `json:"message"`
+}
+
+// Frame represents single frame of trace.
+type Frame struct {
+	Filename string `json:"filename"`
+	Lineno   int    `json:"lineno"`
+	Method   string `json:"method"`
+}
+
+// NewItem returns item with data for given error, which can be sent to
+// Rollbar item API.
+func NewItem(accessToken string, environment string, err error) Item {
+	data := ToData(err)
+	data.Environment = environment
+
+	return Item{
+		AccessToken: accessToken,
+		Data:        data,
+	}
+}
+
+// ToData returns Rollbar data for given error with level, which corresponds
+// to severity of error, see Level().
+func ToData(err error) Data {
+	data := Data{
+		Level:    Level(err),
+		Platform: "go",
+		Language: "go",
+		Body: Body{
+			TraceChain: []Trace{},
+		},
+	}
+
+	if err == nil {
+		return data
+	}
+
+	data.Title, _, _ = hierr.Decompose(err)
+
+	flatten(err, &data.Body.TraceChain)
+
+	fields := hierr.AllFields(err)
+	if len(fields) > 0 {
+		data.Custom = map[string]interface{}{}
+		for _, field := range fields {
+			if _, ok := data.Custom[field.Key]; !ok {
+				data.Custom[field.Key] = hierr.String(field.Value)
+			}
+		}
+	}
+
+	return data
+}
+
+// Level returns Rollbar level, which corresponds to severity of given error,
+// which is returned by hierr.GetSeverity(). Fatal errors are reported with
+// "critical" level.
+func Level(err error) string {
+	switch hierr.GetSeverity(err) {
+	case hierr.SeverityDebug:
+		return "debug"
+	case hierr.SeverityInfo:
+		return "info"
+	case hierr.SeverityWarn:
+		return "warning"
+	case hierr.SeverityFatal:
+		return "critical"
+	}
+
+	return "error"
+}
+
+func flatten(node hierr.NestedError, chain *[]Trace) {
+	message, _, reasons := hierr.Decompose(node)
+
+	// Rollbar expects frames, starting from the outermost call.
+	stack := frames(node)
+
+	trace := []Frame{}
+	for index := len(stack) - 1; index >= 0; index-- {
+		trace = append(trace, Frame{
+			Filename: stack[index].File,
+			Lineno:   stack[index].Line,
+			Method:   stack[index].Function,
+		})
+	}
+
+	*chain = append(*chain, Trace{
+		Frames: trace,
+		Exception: Exception{
+			Class:   reflect.TypeOf(node).String(),
+			Message: message,
+		},
+	})
+
+	for _, reason := range reasons {
+		flatten(reason, chain)
+	}
+}
+
+// frames returns frames of call stack, which is captured by given node
+// itself.
+func frames(node hierr.NestedError) []runtime.Frame {
+	switch err := node.(type) {
+	case hierr.Error:
+		return err.Stack.Frames()
+
+	case *hierr.Error:
+		if err != nil {
+			return err.Stack.Frames()
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package hierrrollbar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleNewItem() {
+	err := hierr.Context(
+		hierr.Errorf(errors.New("exit status 128"), "can't pull remote"),
+		hierr.Context("host", "node-a"),
+	)
+
+	payload, _ := json.MarshalIndent(NewItem("token", "production", err), "", "  ")
+
+	fmt.Println(string(payload))
+
+	// Output:
+	// {
+	//   "access_token": "token",
+	//   "data": {
+	//     "environment": "production",
+	//     "level": "error",
+	//     "platform": "go",
+	//     "language": "go",
+	//     "title": "can't pull remote",
+	//     "body": {
+	//       "trace_chain": [
+	//         {
+	//           "frames": [],
+	//           "exception": {
+	//             "class": "hierr.Error",
+	//             "message": "can't pull remote"
+	//           }
+	//         },
+	//         {
+	//           "frames": [],
+	//           "exception": {
+	//             "class": "*errors.errorString",
+	//             "message": "exit status 128"
+	//           }
+	//         }
+	//       ]
+	//     },
+	//     "custom": {
+	//       "host": "node-a"
+	//     }
+	//   }
+	// }
+}
+
+func ExampleLevel() {
+	fmt.Println(Level(errors.New("connection refused")))
+	fmt.Println(Level(hierr.NewWith("cache miss", hierr.WithSeverity(hierr.SeverityDebug))))
+	fmt.Println(Level(hierr.NewWith("retrying", hierr.WithSeverity(hierr.SeverityInfo))))
+	fmt.Println(Level(hierr.NewWith("slow query", hierr.WithSeverity(hierr.SeverityWarn))))
+	fmt.Println(ToData(hierr.NewWith("out of disk", hierr.WithSeverity(hierr.SeverityFatal))).Level)
+
+	// Output:
+	// error
+	// debug
+	// info
+	// warning
+	// critical
+}
+
+func ExampleToData_stack() {
+	err := hierr.Errorf(
+		hierr.ErrorfStack(errors.New("exit status 128"), "can't pull remote"),
+		"can't deploy",
+	)
+
+	for _, trace := range ToData(err).Body.TraceChain {
+		if len(trace.Frames) == 0 {
+			fmt.Println(trace.Exception.Message, "-")
+			continue
+		}
+
+		fmt.Println(
+			trace.Exception.Message, trace.Frames[len(trace.Frames)-1].Method,
+		)
+	}
+
+	// Output:
+	// can't deploy -
+	// can't pull remote github.com/reconquest/hierr-go/hierrrollbar.ExampleToData_stack
+	// exit status 128 -
+}
@@ -0,0 +1,118 @@
+// Package hierrsentry converts hierarchical errors into Sentry events.
+//
+// Every node of error tree becomes separate exception of chained exception
+// model, so Sentry can group events by their actual causes instead of single
+// flattened string:
+//
+//	sentry.CaptureEvent(hierrsentry.ToSentryEvent(err))
+package hierrsentry // import "github.com/reconquest/hierr-go/hierrsentry"
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/reconquest/hierr-go"
+)
+
+// ToSentryEvent returns Sentry event for given error.
+//
+// Exceptions are ordered from the deepest one to the top-level one, as it's
+// expected by Sentry. Context pairs of the whole tree are reported as event
+// tags, rendered tree is reported as "tree" key of "hierr" context. Stack
+// traces, captured by hierr.ErrorfStack(), are reported as exception stack
+// traces. Level of event corresponds to severity of error, see Level().
+func ToSentryEvent(err error) *sentry.Event {
+	event := sentry.NewEvent()
+	event.Level = sentry.LevelError
+
+	if err == nil {
+		return event
+	}
+
+	message, _, _ := hierr.Decompose(err)
+
+	event.Level = Level(err)
+	event.Message = message
+	event.Contexts["hierr"] = sentry.Context{
+		"tree": hierr.String(err),
+	}
+
+	for _, field := range hierr.AllFields(err) {
+		if _, ok := event.Tags[field.Key]; !ok {
+			event.Tags[field.Key] = hierr.String(field.Value)
+		}
+	}
+
+	exceptions := []sentry.Exception{}
+	convert(err, &exceptions, nil, "")
+
+	if len(exceptions) == 1 {
+		exceptions[0].Mechanism = nil
+	}
+
+	for left, right := 0, len(exceptions)-1; left < right; left, right = left+1, right-1 {
+		exceptions[left], exceptions[right] = exceptions[right], exceptions[left]
+	}
+
+	event.Exception = exceptions
+
+	return event
+}
+
+// Level returns Sentry level, which corresponds to severity of given error,
+// which is returned by hierr.GetSeverity().
+func Level(err error) sentry.Level {
+	switch hierr.GetSeverity(err) {
+	case hierr.SeverityDebug:
+		return sentry.LevelDebug
+	case hierr.SeverityInfo:
+		return sentry.LevelInfo
+	case hierr.SeverityWarn:
+		return sentry.LevelWarning
+	case hierr.SeverityFatal:
+		return sentry.LevelFatal
+	}
+
+	return sentry.LevelError
+}
+
+func convert(
+	node hierr.NestedError,
+	exceptions *[]sentry.Exception,
+	parentID *int,
+	source string,
+) {
+	message, _, reasons := hierr.Decompose(node)
+
+	id := len(*exceptions)
+
+	mechanism := &sentry.Mechanism{
+		Type:             sentry.MechanismTypeChained,
+		ExceptionID:      id,
+		ParentID:         parentID,
+		Source:           source,
+		IsExceptionGroup: len(reasons) > 1,
+	}
+
+	if parentID == nil {
+		mechanism.Type = sentry.MechanismTypeGeneric
+		mechanism.Source = ""
+	}
+
+	exception := sentry.Exception{
+		Type:      reflect.TypeOf(node).String(),
+		Value:     message,
+		Mechanism: mechanism,
+	}
+
+	if err, ok := node.(error); ok {
+		exception.Stacktrace = sentry.ExtractStacktrace(err)
+	}
+
+	*exceptions = append(*exceptions, exception)
+
+	for index, reason := range reasons {
+		convert(reason, exceptions, &id, fmt.Sprintf("reasons[%d]", index))
+	}
+}
@@ -0,0 +1,73 @@
+package hierrsentry
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleToSentryEvent() {
+	err := hierr.Context(
+		hierr.Push(
+			"can't deploy",
+			hierr.Errorf(errors.New("exit status 128"), "can't pull remote"),
+			"disk is full",
+		),
+		hierr.Context("host", "node-a"),
+	)
+
+	event := ToSentryEvent(err)
+
+	fmt.Println("message:", event.Message)
+	fmt.Println("tags:", event.Tags)
+
+	for _, exception := range event.Exception {
+		parent := "-"
+		if exception.Mechanism.ParentID != nil {
+			parent = fmt.Sprint(*exception.Mechanism.ParentID)
+		}
+
+		fmt.Printf(
+			"%d (parent %s, %s, group %t): %s: %s\n",
+			exception.Mechanism.ExceptionID,
+			parent,
+			exception.Mechanism.Type,
+			exception.Mechanism.IsExceptionGroup,
+			exception.Type,
+			exception.Value,
+		)
+	}
+
+	event = ToSentryEvent(errors.New("plain"))
+	fmt.Println(len(event.Exception), event.Exception[0].Mechanism == nil)
+
+	event = ToSentryEvent(hierr.ErrorfStack(nil, "with stack"))
+	frames := event.Exception[0].Stacktrace.Frames
+	fmt.Println(frames[len(frames)-1].Function)
+
+	// Output:
+	// message: can't deploy
+	// tags: map[host:node-a]
+	// 3 (parent 0, chained, group false): string: disk is full
+	// 2 (parent 1, chained, group false): *errors.errorString: exit status 128
+	// 1 (parent 0, chained, group false): hierr.Error: can't pull remote
+	// 0 (parent -, generic, group true): hierr.Error: can't deploy
+	// 1 true
+	// ExampleToSentryEvent
+}
+
+func ExampleLevel() {
+	fmt.Println(Level(errors.New("connection refused")))
+	fmt.Println(Level(hierr.NewWith("cache miss", hierr.WithSeverity(hierr.SeverityDebug))))
+	fmt.Println(Level(hierr.NewWith("retrying", hierr.WithSeverity(hierr.SeverityInfo))))
+	fmt.Println(Level(hierr.NewWith("slow query", hierr.WithSeverity(hierr.SeverityWarn))))
+	fmt.Println(ToSentryEvent(hierr.NewWith("out of disk", hierr.WithSeverity(hierr.SeverityFatal))).Level)
+
+	// Output:
+	// error
+	// debug
+	// info
+	// warning
+	// fatal
+}
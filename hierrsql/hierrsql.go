@@ -0,0 +1,208 @@
+// Package hierrsql wraps errors of database/sql queries into hierarchical
+// errors with redacted statement, number of arguments and duration of query
+// as context pairs:
+//
+//	can't execute query
+//	├─ pq: duplicate key value violates unique constraint "users_pkey"
+//	│
+//	├─ sql code
+//	│  └─ 23505
+//	│
+//	├─ detail
+//	│  └─ Key (id)=(1) already exists.
+//	│
+//	├─ query
+//	│  └─ INSERT INTO users (id, name) VALUES ($1, $2)
+//	│
+//	├─ args
+//	│  └─ 2
+//	│
+//	└─ duration
+//	   └─ 1.2ms
+//
+// Package doesn't import database drivers, instead driver-specific errors,
+// like *pq.Error or *mysql.MySQLError, are recognized by their exported
+// fields, which are reported as context pairs.
+package hierrsql // import "github.com/reconquest/hierr-go/hierrsql"
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/reconquest/hierr-go"
+)
+
+const (
+	// QueryKey is a key of context pair, which contains redacted statement.
+	QueryKey = "query"
+
+	// ArgsKey is a key of context pair, which contains number of arguments
+	// of statement.
+	ArgsKey = "args"
+
+	// CodeKey is a key of context pair, which contains error code of
+	// driver. It differs from hierr.CodeKey, so driver codes are not taken
+	// for exit or HTTP status codes.
+	CodeKey = "sql code"
+)
+
+// Execer is implemented by *sql.DB, *sql.Tx and *sql.Conn.
+type Execer interface {
+	ExecContext(
+		ctx context.Context, query string, args ...interface{},
+	) (sql.Result, error)
+}
+
+// Queryer is implemented by *sql.DB, *sql.Tx and *sql.Conn.
+type Queryer interface {
+	QueryContext(
+		ctx context.Context, query string, args ...interface{},
+	) (*sql.Rows, error)
+}
+
+// driverFields lists exported fields of driver errors, which are reported
+// as context pairs, and their keys.
+var driverFields = []struct {
+	name string
+	key  string
+}{
+	{"Code", CodeKey},
+	{"Number", CodeKey},
+	{"SQLState", "sql state"},
+	{"Detail", "detail"},
+	{"Hint", "hint"},
+	{"Table", "table"},
+	{"Column", "column"},
+	{"Constraint", "constraint"},
+}
+
+var (
+	stringLiteralRegexp = regexp.MustCompile(`'(?:[^']|'')*'`)
+	numberLiteralRegexp = regexp.MustCompile(`(^|[^\w$.])-?\d+(?:\.\d+)?`)
+	whitespaceRegexp    = regexp.MustCompile(`\s+`)
+)
+
+// Exec executes statement as ExecContext() does and wraps its error.
+func Exec(
+	ctx context.Context,
+	db Execer,
+	query string,
+	args ...interface{},
+) (sql.Result, error) {
+	started := time.Now()
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, Wrap(err, "can't execute query", query, len(args), time.Since(started))
+	}
+
+	return result, nil
+}
+
+// Query executes statement as QueryContext() does and wraps its error.
+func Query(
+	ctx context.Context,
+	db Queryer,
+	query string,
+	args ...interface{},
+) (*sql.Rows, error) {
+	started := time.Now()
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, Wrap(err, "can't query", query, len(args), time.Since(started))
+	}
+
+	return rows, nil
+}
+
+// Wrap returns hierarchy error with given message, which contains error of
+// query, fields of driver-specific error, redacted statement, number of
+// arguments and duration as context pairs. Nil is returned if err is nil.
+func Wrap(
+	err error,
+	message string,
+	query string,
+	args int,
+	duration time.Duration,
+) error {
+	if err == nil {
+		return nil
+	}
+
+	nested := append([]hierr.NestedError{err}, driverContext(err)...)
+	nested = append(
+		nested,
+		hierr.Context(QueryKey, Redact(query)),
+		hierr.Context(ArgsKey, args),
+		hierr.Context(hierr.DurationKey, duration),
+	)
+
+	return hierr.Push(message, nested...)
+}
+
+// Redact replaces string and numeric literals of statement with ?
+// placeholders and collapses whitespace, so values, which are inlined into
+// statement, are not reported.
+func Redact(query string) string {
+	query = stringLiteralRegexp.ReplaceAllString(query, "?")
+	query = numberLiteralRegexp.ReplaceAllString(query, "${1}?")
+	query = whitespaceRegexp.ReplaceAllString(query, " ")
+
+	return strings.TrimSpace(query)
+}
+
+// driverContext returns context pairs for exported fields of the first
+// driver-specific error in the chain, which has Message field.
+func driverContext(err error) []hierr.NestedError {
+	for ; err != nil; err = errors.Unwrap(err) {
+		value := reflect.ValueOf(err)
+		if value.Kind() == reflect.Ptr {
+			value = value.Elem()
+		}
+
+		if value.Kind() != reflect.Struct ||
+			!value.FieldByName("Message").IsValid() {
+			continue
+		}
+
+		nested := []hierr.NestedError{}
+		for _, field := range driverFields {
+			fieldValue := value.FieldByName(field.name)
+			if !fieldValue.IsValid() || fieldValue.IsZero() ||
+				!fieldValue.CanInterface() {
+				continue
+			}
+
+			nested = append(
+				nested, hierr.Context(field.key, format(fieldValue)),
+			)
+		}
+
+		return nested
+	}
+
+	return nil
+}
+
+func format(value reflect.Value) interface{} {
+	if value.Kind() == reflect.Array &&
+		value.Type().Elem().Kind() == reflect.Uint8 {
+		bytes := make([]byte, value.Len())
+		reflect.Copy(reflect.ValueOf(bytes), value)
+
+		return string(bytes)
+	}
+
+	if value.Kind() == reflect.String {
+		return fmt.Sprint(value.Interface())
+	}
+
+	return value.Interface()
+}
@@ -0,0 +1,111 @@
+package hierrsql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/reconquest/hierr-go"
+)
+
+type postgresError struct {
+	Code    string
+	Message string
+	Detail  string
+	Hint    string
+}
+
+func (err *postgresError) Error() string {
+	return "pq: " + err.Message
+}
+
+type mysqlError struct {
+	Number   uint16
+	SQLState [5]byte
+	Message  string
+}
+
+func (err *mysqlError) Error() string {
+	return fmt.Sprintf("Error %d: %s", err.Number, err.Message)
+}
+
+type failingDB struct {
+	err error
+}
+
+func (db failingDB) ExecContext(
+	context.Context, string, ...interface{},
+) (sql.Result, error) {
+	return nil, db.err
+}
+
+func (db failingDB) QueryContext(
+	context.Context, string, ...interface{},
+) (*sql.Rows, error) {
+	return nil, db.err
+}
+
+func ExampleExec() {
+	db := failingDB{err: &postgresError{
+		Code:    "23505",
+		Message: `duplicate key value violates unique constraint "users_pkey"`,
+		Detail:  "Key (id)=(1) already exists.",
+	}}
+
+	_, err := Exec(
+		context.Background(), db,
+		"INSERT INTO users (id, name)\n\tVALUES ($1, $2)", 1, "root",
+	)
+
+	err = err.(hierr.Error).WithValue(hierr.DurationKey, time.Millisecond)
+
+	fmt.Println(err)
+
+	// Output:
+	// can't execute query
+	// ├─ pq: duplicate key value violates unique constraint "users_pkey"
+	// │
+	// ├─ sql code
+	// │  └─ 23505
+	// │
+	// ├─ detail
+	// │  └─ Key (id)=(1) already exists.
+	// │
+	// ├─ query
+	// │  └─ INSERT INTO users (id, name) VALUES ($1, $2)
+	// │
+	// ├─ args
+	// │  └─ 2
+	// │
+	// └─ duration
+	//    └─ 1ms
+}
+
+func ExampleQuery() {
+	db := failingDB{err: fmt.Errorf("can't prepare: %w", &mysqlError{
+		Number:   1146,
+		SQLState: [5]byte{'4', '2', 'S', '0', '2'},
+		Message:  "Table 'app.jobs' doesn't exist",
+	})}
+
+	_, err := Query(context.Background(), db, "SELECT * FROM jobs")
+
+	fmt.Println(hierr.AllFields(err)[:2])
+
+	_, err = Query(context.Background(), failingDB{}, "SELECT 1")
+	fmt.Println(err)
+
+	// Output:
+	// [{sql code 1146} {sql state 42S02}]
+	// <nil>
+}
+
+func ExampleRedact() {
+	fmt.Println(Redact(
+		"SELECT * FROM users\n WHERE name = 'O''Brien' AND age > 42 AND id = $1",
+	))
+
+	// Output:
+	// SELECT * FROM users WHERE name = ? AND age > ? AND id = $1
+}
@@ -0,0 +1,249 @@
+// Package hierrsyslog renders hierarchical errors as RFC 5424 syslog
+// messages.
+//
+// Top-level error message is used as message of the first syslog message,
+// context pairs of the whole tree are passed as structured data parameters
+// and nested reasons are emitted as continuation messages, one per rendered
+// line:
+//
+//	<11>1 2017-08-24T10:00:00Z node-a app - - [hierr@32473 remote="origin"] can't pull remote
+//	<11>1 2017-08-24T10:00:00Z node-a app - - - └─ exit status 128
+package hierrsyslog // import "github.com/reconquest/hierr-go/hierrsyslog"
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// Severity represents syslog severity as defined by RFC 5424.
+type Severity int
+
+const (
+	SeverityEmergency Severity = iota
+	SeverityAlert
+	SeverityCritical
+	SeverityError
+	SeverityWarning
+	SeverityNotice
+	SeverityInformational
+	SeverityDebug
+)
+
+// Facility represents syslog facility as defined by RFC 5424.
+type Facility int
+
+const (
+	FacilityKernel Facility = iota
+	FacilityUser
+	FacilityMail
+	FacilityDaemon
+	FacilityAuth
+	FacilitySyslog
+	FacilityLPR
+	FacilityNews
+	FacilityUUCP
+	FacilityCron
+	FacilityAuthPriv
+	FacilityFTP
+	FacilityNTP
+	FacilityAudit
+	FacilityAlert
+	FacilityClock
+	FacilityLocal0
+	FacilityLocal1
+	FacilityLocal2
+	FacilityLocal3
+	FacilityLocal4
+	FacilityLocal5
+	FacilityLocal6
+	FacilityLocal7
+)
+
+// DefaultStructuredDataID is used as SD-ID for context pairs if no other ID
+// is specified.
+const DefaultStructuredDataID = "hierr@32473"
+
+const nilValue = "-"
+
+// Formatter formats errors as RFC 5424 syslog messages. Empty header
+// fields are rendered as NILVALUE.
+type Formatter struct {
+	// Facility is used to calculate message priority.
+	Facility Facility
+
+	// Hostname is HOSTNAME header field.
+	Hostname string
+
+	// AppName is APP-NAME header field.
+	AppName string
+
+	// ProcID is PROCID header field.
+	ProcID string
+
+	// MsgID is MSGID header field.
+	MsgID string
+
+	// StructuredDataID is SD-ID of element, which contains context pairs,
+	// DefaultStructuredDataID is used if empty.
+	StructuredDataID string
+}
+
+// WithSeverity attaches severity to given error, which will be used for
+// calculating priority of syslog messages. Errors without attached severity
+// are reported according to their hierr.Severity.
+func WithSeverity(err error, severity Severity) error {
+	return severityError{err, severity}
+}
+
+// GetSeverity returns severity, attached to given error. If no severity is
+// attached, then severity of hierr error, which is returned by
+// hierr.GetSeverity(), is mapped to syslog severity.
+func GetSeverity(err error) Severity {
+	if err, ok := err.(severityError); ok {
+		return err.severity
+	}
+
+	switch hierr.GetSeverity(err) {
+	case hierr.SeverityDebug:
+		return SeverityDebug
+	case hierr.SeverityInfo:
+		return SeverityInformational
+	case hierr.SeverityWarn:
+		return SeverityWarning
+	case hierr.SeverityFatal:
+		return SeverityCritical
+	}
+
+	return SeverityError
+}
+
+// Format returns syslog messages for given error, stamped with given time.
+func (formatter Formatter) Format(err error, timestamp time.Time) []string {
+	var (
+		fields = hierr.AllFields(err)
+		lines  = strings.Split(hierr.String(strip(err)), "\n")
+		header = formatter.header(GetSeverity(err), timestamp)
+	)
+
+	data := nilValue
+	if len(fields) > 0 {
+		data = formatter.structuredData(fields)
+	}
+
+	messages := []string{header + " " + data + " " + lines[0]}
+	for _, line := range lines[1:] {
+		messages = append(messages, header+" "+nilValue+" "+line)
+	}
+
+	return messages
+}
+
+// Write writes syslog messages for given error, stamped with current time,
+// into specified writer, one message per line.
+func (formatter Formatter) Write(writer io.Writer, err error) error {
+	for _, message := range formatter.Format(err, time.Now()) {
+		_, err := io.WriteString(writer, message+"\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (formatter Formatter) header(
+	severity Severity,
+	timestamp time.Time,
+) string {
+	return fmt.Sprintf(
+		"<%d>1 %s %s %s %s %s",
+		int(formatter.Facility)*8+int(severity),
+		timestamp.Format("2006-01-02T15:04:05.999999Z07:00"),
+		headerField(formatter.Hostname, 255),
+		headerField(formatter.AppName, 48),
+		headerField(formatter.ProcID, 128),
+		headerField(formatter.MsgID, 32),
+	)
+}
+
+func (formatter Formatter) structuredData(fields []hierr.Field) string {
+	id := formatter.StructuredDataID
+	if id == "" {
+		id = DefaultStructuredDataID
+	}
+
+	data := "[" + sanitizeName(id)
+	for _, field := range fields {
+		data += fmt.Sprintf(
+			` %s="%s"`,
+			sanitizeName(field.Key),
+			escapeValue(hierr.String(field.Value)),
+		)
+	}
+
+	return data + "]"
+}
+
+func strip(node hierr.NestedError) hierr.NestedError {
+	if _, ok := node.(hierr.HierarchicalError); !ok {
+		return node
+	}
+
+	message, _, reasons := hierr.Decompose(node)
+
+	stripped := []hierr.NestedError{}
+	for _, reason := range reasons {
+		stripped = append(stripped, strip(reason))
+	}
+
+	return hierr.Push(message, stripped...)
+}
+
+func headerField(value string, limit int) string {
+	value = strings.Map(func(symbol rune) rune {
+		if symbol < 33 || symbol > 126 {
+			return -1
+		}
+
+		return symbol
+	}, value)
+
+	if value == "" {
+		return nilValue
+	}
+
+	if len(value) > limit {
+		value = value[:limit]
+	}
+
+	return value
+}
+
+func sanitizeName(name string) string {
+	name = strings.Map(func(symbol rune) rune {
+		if symbol < 33 || symbol > 126 ||
+			symbol == '=' || symbol == ']' || symbol == '"' {
+			return '_'
+		}
+
+		return symbol
+	}, name)
+
+	if len(name) > 32 {
+		name = name[:32]
+	}
+
+	return name
+}
+
+func escapeValue(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		`]`, `\]`,
+	).Replace(value)
+}
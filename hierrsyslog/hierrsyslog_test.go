@@ -0,0 +1,69 @@
+package hierrsyslog
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/reconquest/hierr-go"
+)
+
+func ExampleFormatter() {
+	formatter := Formatter{
+		Facility: FacilityDaemon,
+		Hostname: "node-a",
+		AppName:  "deployer",
+		ProcID:   "1234",
+	}
+
+	timestamp := time.Date(2017, 8, 24, 10, 0, 0, 0, time.UTC)
+
+	testcases := []error{
+		errors.New("plain error"),
+		WithSeverity(
+			hierr.Context(
+				hierr.Errorf(
+					hierr.Context(
+						errors.New("exit status 128"),
+						hierr.Context("command", `git fetch "origin"`),
+					),
+					"can't pull remote",
+				),
+				hierr.Context("remote", "origin"),
+			),
+			SeverityWarning,
+		),
+	}
+
+	for _, test := range testcases {
+		fmt.Println()
+		fmt.Println("{{{")
+		for _, message := range formatter.Format(test, timestamp) {
+			fmt.Println(message)
+		}
+		fmt.Println("}}}")
+	}
+
+	// Output:
+	//
+	// {{{
+	// <27>1 2017-08-24T10:00:00Z node-a deployer 1234 - - plain error
+	// }}}
+	//
+	// {{{
+	// <28>1 2017-08-24T10:00:00Z node-a deployer 1234 - [hierr@32473 remote="origin" command="git fetch \"origin\""] can't pull remote
+	// <28>1 2017-08-24T10:00:00Z node-a deployer 1234 - - └─ exit status 128
+	// }}}
+}
+
+func ExampleGetSeverity() {
+	warning := hierr.Errorf(nil, "low disk space").(hierr.Error).
+		WithSeverity(hierr.SeverityWarn)
+
+	fmt.Println(GetSeverity(warning) == SeverityWarning)
+	fmt.Println(GetSeverity(WithSeverity(warning, SeverityNotice)) == SeverityNotice)
+
+	// Output:
+	// true
+	// true
+}
@@ -0,0 +1,34 @@
+package hierrsyslog
+
+import (
+	"github.com/reconquest/hierr-go"
+)
+
+type severityError struct {
+	err      error
+	severity Severity
+}
+
+func (err severityError) Error() string {
+	return err.err.Error()
+}
+
+func (err severityError) HierarchicalError() string {
+	return hierr.String(err.err)
+}
+
+func (err severityError) GetNested() []hierr.NestedError {
+	if hierarchical, ok := err.err.(hierr.HierarchicalError); ok {
+		return hierarchical.GetNested()
+	}
+
+	return nil
+}
+
+func (err severityError) GetMessage() string {
+	if hierarchical, ok := err.err.(hierr.HierarchicalError); ok {
+		return hierarchical.GetMessage()
+	}
+
+	return err.err.Error()
+}
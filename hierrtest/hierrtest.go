@@ -0,0 +1,131 @@
+// Package hierrtest provides test assertions for hierarchical errors, which
+// compare error trees structurally instead of comparing rendered strings, so
+// tests are not broken by changes of branch delimiters or indentation:
+//
+//	func TestPull(t *testing.T) {
+//		err := pull("origin")
+//
+//		hierrtest.AssertHasContext(t, err, "remote", "origin")
+//		hierrtest.AssertLeaf(t, err, os.ErrNotExist)
+//	}
+package hierrtest // import "github.com/reconquest/hierr-go/hierrtest"
+
+import (
+	"os"
+
+	"github.com/reconquest/hierr-go"
+)
+
+// UpdateGolden set whether AssertGolden() should overwrite golden files with
+// actual snapshots of errors instead of comparing them, it's usually bound to
+// the -update flag of test binary in TestMain().
+var UpdateGolden = false
+
+// TestingT is a subset of testing.TB, which is used by assertions to report
+// failures.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// AssertTree asserts that error has the same messages, structure and
+// context as wanted error, as reported by hierr.Equal().
+func AssertTree(t TestingT, err error, want error) bool {
+	t.Helper()
+
+	if hierr.Equal(err, want) {
+		return true
+	}
+
+	t.Errorf(
+		"error tree mismatch:\n%s\n\nactual:\n%s\n\nexpected:\n%s",
+		hierr.Diff(want, err), render(err), render(want),
+	)
+
+	return false
+}
+
+// AssertHasContext asserts that error or any of its nested reasons has
+// context pair with given key and value. Values are compared by their string
+// representation.
+func AssertHasContext(
+	t TestingT,
+	err error,
+	key string,
+	value interface{},
+) bool {
+	t.Helper()
+
+	for _, field := range hierr.AllFields(err) {
+		if field.Key == key && hierr.String(field.Value) == hierr.String(value) {
+			return true
+		}
+	}
+
+	t.Errorf(
+		"error has no context %q with value %q:\n%s",
+		key, hierr.String(value), render(err),
+	)
+
+	return false
+}
+
+// AssertLeaf asserts that any terminal reason of the error tree matches
+// target, as reported by errors.Is().
+func AssertLeaf(t TestingT, err error, target error) bool {
+	t.Helper()
+
+	if err != nil {
+		for _, leaf := range (hierr.Error{Nested: err}).Leaves() {
+			if leaf, ok := leaf.(error); ok && hierr.AnyIs(leaf, target) {
+				return true
+			}
+		}
+	}
+
+	t.Errorf("error has no leaf %q:\n%s", render(target), render(err))
+
+	return false
+}
+
+// AssertGolden asserts that deterministic snapshot of error, which is
+// returned by hierr.Snapshot(), equals to contents of the golden file.
+func AssertGolden(t TestingT, err error, path string) bool {
+	t.Helper()
+
+	actual := hierr.Snapshot(err) + "\n"
+
+	if UpdateGolden {
+		if err := os.WriteFile(path, []byte(actual), 0644); err != nil {
+			t.Errorf("can't update golden file %s: %s", path, err)
+			return false
+		}
+
+		return true
+	}
+
+	expected, readErr := os.ReadFile(path)
+	if readErr != nil {
+		t.Errorf("can't read golden file %s: %s", path, readErr)
+		return false
+	}
+
+	if string(expected) == actual {
+		return true
+	}
+
+	t.Errorf(
+		"error snapshot mismatch with golden file %s:\n\nactual:\n%s\nexpected:\n%s",
+		path, actual, expected,
+	)
+
+	return false
+}
+
+func render(err error) string {
+	if err == nil {
+		return "<nil>"
+	}
+
+	return hierr.String(err)
+}
@@ -0,0 +1,142 @@
+package hierrtest
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/reconquest/hierr-go"
+)
+
+type recorder struct {
+	failures []string
+}
+
+func (recorder *recorder) Helper() {}
+
+func (recorder *recorder) Errorf(format string, args ...interface{}) {
+	recorder.failures = append(recorder.failures, fmt.Sprintf(format, args...))
+}
+
+func ExampleAssertTree() {
+	t := &recorder{}
+
+	err := hierr.Context(
+		hierr.Errorf(os.ErrNotExist, "can't open config"),
+		hierr.Context("path", "/etc/app.conf"),
+	)
+
+	fmt.Println(AssertTree(t, err, hierr.Context(
+		hierr.Errorf(os.ErrNotExist, "can't open config"),
+		hierr.Context("path", "/etc/app.conf"),
+	)))
+
+	fmt.Println(AssertTree(t, err, errors.New("can't open config")))
+	fmt.Println(len(t.failures))
+
+	// Output:
+	// true
+	// false
+	// 1
+}
+
+func ExampleAssertHasContext() {
+	t := &recorder{}
+
+	err := hierr.Push(
+		"can't deploy",
+		hierr.Context(
+			hierr.Errorf(errors.New("connection refused"), "can't connect"),
+			hierr.Context("host", "example.com"),
+			hierr.Context("port", 22),
+		),
+	)
+
+	fmt.Println(AssertHasContext(t, err, "host", "example.com"))
+	fmt.Println(AssertHasContext(t, err, "port", 22))
+	fmt.Println(AssertHasContext(t, err, "host", "example.org"))
+	fmt.Println(t.failures[0])
+
+	// Output:
+	// true
+	// true
+	// false
+	// error has no context "host" with value "example.org":
+	// can't deploy
+	// └─ can't connect
+	//    ├─ connection refused
+	//    │
+	//    ├─ host
+	//    │  └─ example.com
+	//    │
+	//    └─ port
+	//       └─ 22
+}
+
+func ExampleAssertLeaf() {
+	t := &recorder{}
+
+	err := hierr.Push(
+		"can't load configs",
+		hierr.Errorf(os.ErrNotExist, "can't open /etc/app.conf"),
+		hierr.Errorf(os.ErrPermission, "can't open /home/user/.app.conf"),
+	)
+
+	fmt.Println(AssertLeaf(t, err, os.ErrPermission))
+	fmt.Println(AssertLeaf(t, err, os.ErrClosed))
+	fmt.Println(AssertLeaf(t, nil, os.ErrClosed))
+	fmt.Println(len(t.failures))
+
+	// Output:
+	// true
+	// false
+	// false
+	// 2
+}
+
+func ExampleAssertGolden() {
+	defer func() {
+		UpdateGolden = false
+	}()
+
+	t := &recorder{}
+
+	directory, err := os.MkdirTemp("", "hierrtest")
+	if err != nil {
+		panic(err)
+	}
+
+	defer os.RemoveAll(directory)
+
+	path := filepath.Join(directory, "pull.golden")
+
+	tree := hierr.Context(
+		hierr.Errorf(errors.New("exit status 128"), "can't pull remote"),
+		hierr.Context("remote", "origin"),
+	)
+
+	UpdateGolden = true
+	fmt.Println(AssertGolden(t, tree, path))
+
+	UpdateGolden = false
+	fmt.Println(AssertGolden(t, tree, path))
+	fmt.Println(AssertGolden(t, errors.New("can't pull remote"), path))
+
+	golden, err := os.ReadFile(path)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Print(string(golden))
+
+	// Output:
+	// true
+	// true
+	// false
+	// can't pull remote
+	// ├─ exit status 128
+	// │
+	// └─ remote
+	//    └─ origin
+}
@@ -0,0 +1,75 @@
+// Package hierrvalidator expands validation errors of
+// github.com/go-playground/validator into hierarchical errors, where every
+// failed field gets own branch:
+//
+//	validation failed
+//	├─ User.Email
+//	│  ├─ field
+//	│  │  └─ Email
+//	│  │
+//	│  ├─ tag
+//	│  │  └─ email
+//	│  │
+//	│  └─ value
+//	│     └─ root@
+//	│
+//	└─ User.Age
+//	   ...
+package hierrvalidator // import "github.com/reconquest/hierr-go/hierrvalidator"
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/reconquest/hierr-go"
+)
+
+const (
+	// FieldKey is a key of context pair, which contains name of field.
+	FieldKey = "field"
+
+	// TagKey is a key of context pair, which contains validation tag, which
+	// failed.
+	TagKey = "tag"
+
+	// ParamKey is a key of context pair, which contains parameter of
+	// validation tag, like 18 for min=18.
+	ParamKey = "param"
+
+	// ValueKey is a key of context pair, which contains invalid value.
+	ValueKey = "value"
+)
+
+// ValidationMessage set message of error, which is returned by Decompose().
+var ValidationMessage = "validation failed"
+
+// Decompose converts validator.ValidationErrors into hierarchical error with
+// ValidationMessage message, which reasons are failed fields. Every reason
+// has namespace of field, like "User.Email", as message and name of field,
+// validation tag, its parameter and invalid value as context pairs. Other
+// errors are returned as is.
+func Decompose(err error) error {
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) || len(errs) == 0 {
+		return err
+	}
+
+	reasons := []hierr.NestedError{}
+	for _, failure := range errs {
+		nested := []hierr.NestedError{
+			hierr.Context(FieldKey, failure.Field()),
+			hierr.Context(TagKey, failure.Tag()),
+		}
+
+		if param := failure.Param(); param != "" {
+			nested = append(nested, hierr.Context(ParamKey, param))
+		}
+
+		nested = append(nested, hierr.Context(ValueKey, failure.Value()))
+
+		reasons = append(reasons, hierr.Push(failure.Namespace(), nested...))
+	}
+
+	return hierr.Push(ValidationMessage, reasons...)
+}
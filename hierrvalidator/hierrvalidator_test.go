@@ -0,0 +1,46 @@
+package hierrvalidator
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+)
+
+func ExampleDecompose() {
+	type User struct {
+		Email string `validate:"required,email"`
+		Age   int    `validate:"min=18"`
+	}
+
+	err := validator.New().Struct(User{Email: "root@", Age: 16})
+
+	fmt.Println(Decompose(err))
+	fmt.Println(Decompose(errors.New("timeout")))
+
+	// Output:
+	// validation failed
+	// ├─ User.Email
+	// │  ├─ field
+	// │  │  └─ Email
+	// │  │
+	// │  ├─ tag
+	// │  │  └─ email
+	// │  │
+	// │  └─ value
+	// │     └─ root@
+	// │
+	// └─ User.Age
+	//    ├─ field
+	//    │  └─ Age
+	//    │
+	//    ├─ tag
+	//    │  └─ min
+	//    │
+	//    ├─ param
+	//    │  └─ 18
+	//    │
+	//    └─ value
+	//       └─ 16
+	// timeout
+}
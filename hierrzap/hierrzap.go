@@ -0,0 +1,115 @@
+// Package hierrzap provides native encoding of hierarchical errors for zap
+// logger.
+//
+// Instead of flattening error tree into single string:
+//
+//	logger.Error("can't pull", zap.Error(err))
+//
+// Encode it as nested object with message, context and reasons:
+//
+//	logger.Error("can't pull", hierrzap.Error(err))
+package hierrzap // import "github.com/reconquest/hierr-go/hierrzap"
+
+import (
+	"github.com/reconquest/hierr-go"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Marshaler returns zap object marshaler for given error, which can be
+// used as zap.Object("error", hierrzap.Marshaler(err)).
+func Marshaler(err error) zapcore.ObjectMarshaler {
+	return node{err}
+}
+
+// Error is shorthand for NamedError("error", err), mirroring zap.Error().
+func Error(err error) zap.Field {
+	return NamedError("error", err)
+}
+
+// NamedError constructs field, which encodes given error tree under
+// specified key. If err is nil, field is a no-op.
+func NamedError(key string, err error) zap.Field {
+	if err == nil {
+		return zap.Skip()
+	}
+
+	return zap.Object(key, Marshaler(err))
+}
+
+type node struct {
+	nested hierr.NestedError
+}
+
+type contextFields []hierr.Field
+
+type nestedReasons []hierr.NestedError
+
+// MarshalLogObject encodes node message, its context and its reasons.
+func (node node) MarshalLogObject(encoder zapcore.ObjectEncoder) error {
+	message, fields, reasons := hierr.Decompose(node.nested)
+
+	encoder.AddString("message", message)
+
+	if len(fields) > 0 {
+		err := encoder.AddObject("context", contextFields(fields))
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(reasons) > 0 {
+		err := encoder.AddArray("reasons", nestedReasons(reasons))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalLogObject encodes context fields, keeping native types of values.
+func (fields contextFields) MarshalLogObject(encoder zapcore.ObjectEncoder) error {
+	for _, field := range fields {
+		value := field.Value
+		if bytes, ok := value.([]byte); ok {
+			value = string(bytes)
+		}
+
+		zap.Any(field.Key, value).AddTo(encoder)
+	}
+
+	return nil
+}
+
+// MarshalLogArray encodes every reason as nested object.
+func (reasons nestedReasons) MarshalLogArray(encoder zapcore.ArrayEncoder) error {
+	for _, reason := range reasons {
+		err := encoder.AppendObject(node{reason})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Level returns zap level, which corresponds to severity of given error,
+// which is returned by hierr.GetSeverity(), so error can be logged using
+// logger.Log(hierrzap.Level(err), ...). Note, that zap terminates process
+// after logging message with zapcore.FatalLevel, which is returned for
+// hierr.SeverityFatal.
+func Level(err error) zapcore.Level {
+	switch hierr.GetSeverity(err) {
+	case hierr.SeverityDebug:
+		return zapcore.DebugLevel
+	case hierr.SeverityInfo:
+		return zapcore.InfoLevel
+	case hierr.SeverityWarn:
+		return zapcore.WarnLevel
+	case hierr.SeverityFatal:
+		return zapcore.FatalLevel
+	}
+
+	return zapcore.ErrorLevel
+}
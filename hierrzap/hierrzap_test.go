@@ -0,0 +1,51 @@
+package hierrzap
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/reconquest/hierr-go"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func ExampleError() {
+	logger := zap.New(zapcore.NewCore(
+		zapcore.NewJSONEncoder(zapcore.EncoderConfig{
+			MessageKey: "msg",
+		}),
+		zapcore.AddSync(os.Stdout),
+		zapcore.DebugLevel,
+	))
+
+	err := hierr.Context(
+		hierr.Errorf(
+			hierr.Errorf(errors.New("exit status 128"), "can't run git fetch"),
+			"can't pull remote 'origin'",
+		),
+		hierr.Context("remote", "origin"),
+		hierr.Context("attempt", 3),
+	)
+
+	logger.Error("pull failed", Error(err))
+	logger.Error("pull failed", NamedError("cause", errors.New("plain")))
+	logger.Error("pull failed", Error(nil))
+
+	// Output:
+	// {"msg":"pull failed","error":{"message":"can't pull remote 'origin'","context":{"remote":"origin","attempt":3},"reasons":[{"message":"can't run git fetch","reasons":[{"message":"exit status 128"}]}]}}
+	// {"msg":"pull failed","cause":{"message":"plain"}}
+	// {"msg":"pull failed"}
+}
+
+func ExampleLevel() {
+	warning := hierr.Errorf(nil, "low disk space").(hierr.Error).
+		WithSeverity(hierr.SeverityWarn)
+
+	fmt.Println(Level(warning))
+	fmt.Println(Level(errors.New("connection refused")))
+
+	// Output:
+	// warn
+	// error
+}
@@ -0,0 +1,86 @@
+// Package hierrzerolog provides native encoding of hierarchical errors for
+// zerolog logger.
+//
+// Error tree is encoded as nested objects, where context keys of every node
+// are promoted to the fields of corresponding object:
+//
+//	zerolog.ErrorMarshalFunc = hierrzerolog.MarshalError
+//
+//	logger.Error().Err(err).Msg("can't pull")
+package hierrzerolog // import "github.com/reconquest/hierr-go/hierrzerolog"
+
+import (
+	"github.com/reconquest/hierr-go"
+	"github.com/rs/zerolog"
+)
+
+// MarshalError can be used as zerolog.ErrorMarshalFunc. Hierarchical errors
+// are encoded as nested objects, all other errors are passed through as is,
+// so they are encoded by zerolog as usual.
+func MarshalError(err error) interface{} {
+	if _, ok := err.(hierr.HierarchicalError); ok {
+		return Marshaler(err)
+	}
+
+	return err
+}
+
+// Marshaler returns zerolog object marshaler for given error, which can be
+// used as event.Object("error", hierrzerolog.Marshaler(err)).
+func Marshaler(err error) zerolog.LogObjectMarshaler {
+	return node{err}
+}
+
+type node struct {
+	nested hierr.NestedError
+}
+
+type nestedReasons []hierr.NestedError
+
+// MarshalZerologObject encodes node message, its context as fields and its
+// reasons.
+func (node node) MarshalZerologObject(event *zerolog.Event) {
+	message, fields, reasons := hierr.Decompose(node.nested)
+
+	event.Str("message", message)
+
+	for _, field := range fields {
+		switch value := field.Value.(type) {
+		case string:
+			event.Str(field.Key, value)
+		case []byte:
+			event.Str(field.Key, string(value))
+		default:
+			event.Interface(field.Key, value)
+		}
+	}
+
+	if len(reasons) > 0 {
+		event.Array("reasons", nestedReasons(reasons))
+	}
+}
+
+// MarshalZerologArray encodes every reason as nested object.
+func (reasons nestedReasons) MarshalZerologArray(array *zerolog.Array) {
+	for _, reason := range reasons {
+		array.Object(node{reason})
+	}
+}
+
+// Level returns zerolog level, which corresponds to severity of given
+// error, which is returned by hierr.GetSeverity(), so error can be logged
+// using logger.WithLevel(hierrzerolog.Level(err)).
+func Level(err error) zerolog.Level {
+	switch hierr.GetSeverity(err) {
+	case hierr.SeverityDebug:
+		return zerolog.DebugLevel
+	case hierr.SeverityInfo:
+		return zerolog.InfoLevel
+	case hierr.SeverityWarn:
+		return zerolog.WarnLevel
+	case hierr.SeverityFatal:
+		return zerolog.FatalLevel
+	}
+
+	return zerolog.ErrorLevel
+}
@@ -0,0 +1,48 @@
+package hierrzerolog
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/reconquest/hierr-go"
+	"github.com/rs/zerolog"
+)
+
+func ExampleMarshalError() {
+	defer func(marshal func(error) interface{}) {
+		zerolog.ErrorMarshalFunc = marshal
+	}(zerolog.ErrorMarshalFunc)
+
+	zerolog.ErrorMarshalFunc = MarshalError
+
+	logger := zerolog.New(os.Stdout)
+
+	err := hierr.Context(
+		hierr.Errorf(
+			hierr.Errorf(errors.New("exit status 128"), "can't run git fetch"),
+			"can't pull remote 'origin'",
+		),
+		hierr.Context("remote", "origin"),
+		hierr.Context("attempt", 3),
+	)
+
+	logger.Error().Err(err).Msg("pull failed")
+	logger.Error().Err(errors.New("plain")).Msg("pull failed")
+
+	// Output:
+	// {"level":"error","error":{"message":"can't pull remote 'origin'","remote":"origin","attempt":3,"reasons":[{"message":"can't run git fetch","reasons":[{"message":"exit status 128"}]}]},"message":"pull failed"}
+	// {"level":"error","error":"plain","message":"pull failed"}
+}
+
+func ExampleLevel() {
+	warning := hierr.Errorf(nil, "low disk space").(hierr.Error).
+		WithSeverity(hierr.SeverityWarn)
+
+	fmt.Println(Level(warning))
+	fmt.Println(Level(errors.New("connection refused")))
+
+	// Output:
+	// warn
+	// error
+}
@@ -0,0 +1,65 @@
+package hierr
+
+// HintPrefix is a prefix of hints, which are rendered as branches at the end
+// of the error tree.
+var HintPrefix = "hint: "
+
+// hintColor is an ANSI escape sequence, which is used for hints, if
+// Colorize is set.
+const hintColor = "\x1b[32m"
+
+// WithHint returns copy of the error with given hint, which is actionable
+// guidance for user, like "try running with --force". Hints are not
+// reasons: they are rendered as separate branches with HintPrefix after all
+// nested errors and are not returned by GetNested().
+//
+//	can't remove directory
+//	├─ directory is not empty
+//	└─ hint: try running with --force
+func (err Error) WithHint(hint string) Error {
+	hints := append(err.GetHints(), hint)
+	err.hints = &hints
+
+	return err
+}
+
+// GetHints returns hints, which are attached to the error.
+func (err Error) GetHints() []string {
+	if err.hints == nil {
+		return nil
+	}
+
+	return append([]string{}, *err.hints...)
+}
+
+// AllHints returns hints of given error and all its nested reasons, in order
+// of descending into the tree.
+func AllHints(node NestedError) []string {
+	hints := []string{}
+
+	if err, ok := dereference(node).(Error); ok {
+		hints = append(hints, err.GetHints()...)
+	}
+
+	_, _, reasons := Decompose(node)
+	for _, reason := range reasons {
+		hints = append(hints, AllHints(reason)...)
+	}
+
+	return hints
+}
+
+// formatHints returns hints of the error as nested branches.
+func formatHints(hints []string, options rendering) []NestedError {
+	branches := []NestedError{}
+	for _, hint := range hints {
+		hint = options.hint + hint
+		if options.colors {
+			hint = hintColor + hint + "\x1b[0m"
+		}
+
+		branches = append(branches, hint)
+	}
+
+	return branches
+}
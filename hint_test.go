@@ -0,0 +1,47 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleError_WithHint() {
+	err := Errorf(errors.New("directory is not empty"), "can't remove directory").(Error).
+		WithHint("try running with --force")
+
+	fmt.Println(err)
+
+	wrapped := Errorf(err, "can't clean up").(Error).
+		WithHint("check permissions of /tmp")
+
+	fmt.Println(wrapped)
+	fmt.Println(AllHints(wrapped))
+	fmt.Println(len(wrapped.GetNested()))
+
+	// Output:
+	// can't remove directory
+	// ├─ directory is not empty
+	// └─ hint: try running with --force
+	// can't clean up
+	// ├─ can't remove directory
+	// │  ├─ directory is not empty
+	// │  └─ hint: try running with --force
+	// │
+	// └─ hint: check permissions of /tmp
+	// [check permissions of /tmp try running with --force]
+	// 1
+}
+
+func ExampleError_GetHints() {
+	sentinel := Errorf(nil, "not found")
+	hinted := sentinel.(Error).WithHint("check spelling")
+
+	fmt.Println(sentinel == sentinel)
+	fmt.Println(error(hinted) == sentinel)
+	fmt.Println(hinted.GetHints(), sentinel.(Error).GetHints())
+
+	// Output:
+	// true
+	// false
+	// [check spelling] []
+}
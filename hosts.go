@@ -0,0 +1,99 @@
+package hierr
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// HostsKey is a key of context pair, which lists hosts affected by error
+// grouped by GroupByHost().
+const HostsKey = "hosts"
+
+// GroupByHost creates new hierarchy error with given message, which reasons
+// are failures of specified hosts. Identical failures are grouped into one
+// reason with the list of affected hosts added as context pair with HostsKey
+// key, instead of being reported once per host. Groups are ordered by the
+// first affected host. Reasons, which are not hierr errors, are kept, so
+// they still can be matched by errors.Is() and errors.As().
+//
+// Nil errors are ignored and nil is returned if no host has failed.
+func GroupByHost(message string, failures map[string]error) error {
+	hosts := []string{}
+	for host, err := range failures {
+		if err != nil {
+			hosts = append(hosts, host)
+		}
+	}
+
+	if len(hosts) == 0 {
+		return nil
+	}
+
+	sort.Strings(hosts)
+
+	var (
+		groups    = [][]string{}
+		reasons   = []error{}
+		positions = map[string]int{}
+	)
+
+	for _, host := range hosts {
+		text := String(failures[host])
+
+		position, ok := positions[text]
+		if !ok {
+			position = len(groups)
+			positions[text] = position
+
+			groups = append(groups, nil)
+			reasons = append(reasons, failures[host])
+		}
+
+		groups[position] = append(groups[position], host)
+	}
+
+	nested := []NestedError{}
+	for index, reason := range reasons {
+		group := Context(reason, Context(HostsKey, strings.Join(groups[index], ", ")))
+
+		if _, ok := reason.(Error); !ok {
+			group = hostsError{tree: group.(Error), reason: reason}
+		}
+
+		nested = append(nested, group)
+	}
+
+	return Push(message, nested...)
+}
+
+// hostsError is a group of GroupByHost(), which keeps original reason, so
+// it can be unwrapped.
+type hostsError struct {
+	tree   Error
+	reason error
+}
+
+func (err hostsError) Error() string {
+	return err.tree.Error()
+}
+
+func (err hostsError) Format(state fmt.State, verb rune) {
+	err.tree.Format(state, verb)
+}
+
+func (err hostsError) HierarchicalError() string {
+	return err.tree.HierarchicalError()
+}
+
+func (err hostsError) GetNested() []NestedError {
+	return err.tree.GetNested()
+}
+
+func (err hostsError) GetMessage() string {
+	return err.tree.GetMessage()
+}
+
+func (err hostsError) Unwrap() error {
+	return err.reason
+}
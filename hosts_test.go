@@ -0,0 +1,56 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+)
+
+func ExampleGroupByHost() {
+	refused := func() error {
+		return Errorf(errors.New("connection refused"), "can't connect")
+	}
+
+	fmt.Println(
+		GroupByHost("can't run command", map[string]error{
+			"node-c": refused(),
+			"node-a": refused(),
+			"node-b": errors.New("disk is full"),
+			"node-d": refused(),
+			"node-e": nil,
+		}),
+	)
+
+	fmt.Println(GroupByHost("can't run command", map[string]error{
+		"node-a": nil,
+	}))
+
+	// Output:
+	// can't run command
+	// ├─ can't connect
+	// │  ├─ connection refused
+	// │  │
+	// │  └─ hosts
+	// │     └─ node-a, node-c, node-d
+	// │
+	// └─ disk is full
+	//    └─ hosts
+	//       └─ node-b
+	// <nil>
+}
+
+func ExampleGroupByHost_unwrap() {
+	err := GroupByHost("can't run command", map[string]error{
+		"node-a": syscall.ECONNREFUSED,
+		"node-b": syscall.ECONNREFUSED,
+		"node-c": &os.PathError{Op: "open", Path: "/etc/app", Err: os.ErrNotExist},
+	})
+
+	fmt.Println(AnyIs(err, syscall.ECONNREFUSED))
+	fmt.Println(AsAll[*os.PathError](err)[0].Path)
+
+	// Output:
+	// true
+	// /etc/app
+}
@@ -0,0 +1,71 @@
+package hierr
+
+import (
+	"net/http"
+)
+
+// HTTPStatusKey is a key of context pair, which contains HTTP status code,
+// which should be used to report error.
+const HTTPStatusKey = "status code"
+
+// WithHTTPStatus adds context pair with HTTPStatusKey key and given HTTP
+// status code to the error. Nil is returned if err is nil.
+func WithHTTPStatus(err error, status int) error {
+	if err == nil {
+		return nil
+	}
+
+	return Context(err, Context(HTTPStatusKey, status))
+}
+
+// HTTPStatus returns the most specific HTTP status code of error, which is
+// taken from context pairs with HTTPStatusKey key, if its value is integer
+// in range 400-599. Pairs with CodeKey key are not used, since such codes
+// are not necessarily HTTP status codes, like exit codes. Pair of the deepest node wins, since
+// it's the closest one to the root cause, if nodes have the same depth, the
+// first one wins.
+//
+// http.StatusInternalServerError is returned if no node has status code, and
+// http.StatusOK is returned for nil error.
+func HTTPStatus(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+
+	if status, _, ok := httpStatus(err, 0); ok {
+		return status
+	}
+
+	return http.StatusInternalServerError
+}
+
+func httpStatus(node NestedError, depth int) (int, int, bool) {
+	_, fields, reasons := Decompose(node)
+
+	var (
+		status  int
+		deepest int
+		found   bool
+	)
+
+	for _, field := range fields {
+		code, ok := field.Value.(int)
+		if !ok {
+			continue
+		}
+
+		if field.Key == HTTPStatusKey && code >= 400 && code <= 599 {
+			status, deepest, found = code, depth, true
+			break
+		}
+	}
+
+	for _, reason := range reasons {
+		code, level, ok := httpStatus(reason, depth+1)
+		if ok && (!found || level > deepest) {
+			status, deepest, found = code, level, true
+		}
+	}
+
+	return status, deepest, found
+}
@@ -0,0 +1,31 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleHTTPStatus() {
+	notFound := WithHTTPStatus(errors.New("job not found"), 404)
+
+	fmt.Println(HTTPStatus(nil))
+	fmt.Println(HTTPStatus(errors.New("timeout")))
+	fmt.Println(HTTPStatus(notFound))
+	fmt.Println(HTTPStatus(WithHTTPStatus(Errorf(notFound, "can't get job"), 400)))
+	fmt.Println(HTTPStatus(Push(
+		"can't create jobs",
+		WithHTTPStatus(errors.New("quota exceeded"), 429),
+		notFound,
+	)))
+	fmt.Println(HTTPStatus(WithHTTPStatus(errors.New("redirected"), 302)))
+	fmt.Println(HTTPStatus(Build("exit status 404").Code(404).Err()))
+
+	// Output:
+	// 200
+	// 500
+	// 404
+	// 404
+	// 429
+	// 500
+	// 500
+}
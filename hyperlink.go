@@ -0,0 +1,95 @@
+package hierr
+
+import (
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var (
+	// Hyperlinks set whether recorded caller locations should be rendered
+	// as OSC 8 terminal hyperlinks. Use EnableHyperlinks() to enable them
+	// only if output is a terminal, which supports hyperlinks.
+	Hyperlinks = false
+
+	// HyperlinkTemplate set URL of hyperlinks, where {file}, {line} and
+	// {function} placeholders are replaced with full path to the source
+	// file, line number and function name respectively. Path and function
+	// name are escaped, so they can be used as URL path.
+	//
+	// Use: hierr.HyperlinkTemplate = "vscode://file/{file}:{line}"
+	HyperlinkTemplate = "file://{file}"
+)
+
+// EnableHyperlinks enables hyperlinks if given file is a terminal, which
+// supports OSC 8 hyperlinks, and returns true if hyperlinks were enabled.
+// If settings are set by Configure(), they are updated via Configure(),
+// otherwise Hyperlinks package variable is set.
+//
+// Use: hierr.EnableHyperlinks(os.Stderr)
+func EnableHyperlinks(file *os.File) bool {
+	enabled := IsHyperlinkTerminal(file)
+
+	for {
+		settings := config.Load()
+		if settings == nil {
+			Hyperlinks = enabled
+			break
+		}
+
+		updated := *settings
+		updated.Hyperlinks = enabled
+
+		if config.CompareAndSwap(settings, &updated) {
+			break
+		}
+	}
+
+	return enabled
+}
+
+// IsHyperlinkTerminal returns true if given file is a terminal, which is
+// known to support OSC 8 hyperlinks.
+func IsHyperlinkTerminal(file *os.File) bool {
+	stat, err := file.Stat()
+	if err != nil || stat.Mode()&os.ModeCharDevice == 0 {
+		return false
+	}
+
+	if os.Getenv("TERM") == "dumb" {
+		return false
+	}
+
+	switch os.Getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "vscode", "Hyper", "ghostty":
+		return true
+	}
+
+	for _, variable := range []string{
+		"WT_SESSION", "KITTY_WINDOW_ID", "KONSOLE_VERSION",
+	} {
+		if os.Getenv(variable) != "" {
+			return true
+		}
+	}
+
+	version, err := strconv.Atoi(os.Getenv("VTE_VERSION"))
+	if err == nil && version >= 5000 {
+		return true
+	}
+
+	return false
+}
+
+// hyperlink returns given text wrapped into OSC 8 hyperlink, pointing to the
+// location of specified caller.
+func hyperlink(text string, caller *Caller, template string) string {
+	link := strings.NewReplacer(
+		"{file}", (&url.URL{Path: caller.File}).EscapedPath(),
+		"{line}", strconv.Itoa(caller.Line),
+		"{function}", url.PathEscape(caller.Function),
+	).Replace(template)
+
+	return "\x1b]8;;" + link + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+}
@@ -0,0 +1,67 @@
+package hierr
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+)
+
+func ExampleHyperlinks() {
+	defer func() {
+		Hyperlinks = false
+		HyperlinkTemplate = "file://{file}"
+	}()
+
+	Hyperlinks = true
+	HyperlinkTemplate = "https://example.com/{function}#L{line}"
+
+	err := Error{
+		Message: "can't pull remote",
+		Caller: &Caller{
+			Function: "main.pull",
+			File:     "/src/app/main.go",
+			Line:     12,
+		},
+	}
+
+	fmt.Printf("%q\n", err.Error())
+
+	// Output:
+	// "can't pull remote (\x1b]8;;https://example.com/main.pull#L12\x1b\\app/main.go:12\x1b]8;;\x1b\\)"
+}
+
+func ExampleHyperlinks_escaping() {
+	defer func() {
+		Hyperlinks = false
+	}()
+
+	Hyperlinks = true
+
+	err := Error{
+		Message: "can't pull remote",
+		Caller: &Caller{
+			Function: "main.pull",
+			File:     "/src/my app/#1?/main.go",
+			Line:     12,
+		},
+	}
+
+	fmt.Printf("%q\n", err.Error())
+
+	// Output:
+	// "can't pull remote (\x1b]8;;file:///src/my%20app/%231%3F/main.go\x1b\\#1?/main.go:12\x1b]8;;\x1b\\)"
+}
+
+func ExampleIsHyperlinkTerminal() {
+	file, err := ioutil.TempFile(os.TempDir(), "output")
+	if err != nil {
+		panic(err)
+	}
+
+	defer os.Remove(file.Name())
+
+	fmt.Println(IsHyperlinkTerminal(file))
+
+	// Output:
+	// false
+}
@@ -0,0 +1,72 @@
+package hierr
+
+import (
+	"crypto/rand"
+	"time"
+)
+
+// RecordID set whether Errorf() should assign unique ID to every created
+// error, which is rendered after message of top-level error, so error
+// reference, which is reported by user, can be matched with the whole tree
+// in server logs:
+//
+//	can't process request [error ref: 01HZX3V1Q8M4N7P2R5S9T6W0YB]
+//	└─ connection refused
+//
+// Error, which wraps single hierr error with ID, takes over its ID, so the
+// whole tree has single reference.
+var RecordID = false
+
+// crockford is an alphabet of Crockford's base32 encoding, which is used by
+// ULID.
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// GetID returns unique ID of error, which is assigned when error is created,
+// if RecordID is set, empty string is returned otherwise.
+func (err Error) GetID() string {
+	return err.ID
+}
+
+// assignID returns ID of given nested error, if it's hierr error with ID,
+// or new ID otherwise.
+func assignID(nestedError NestedError) string {
+	if nested, ok := dereference(nestedError).(Error); ok && nested.ID != "" {
+		return nested.ID
+	}
+
+	return newID(time.Now())
+}
+
+// newID returns ULID, which consists of 48 bits of timestamp in milliseconds
+// and 80 random bits, encoded using Crockford's base32 into 26 characters.
+func newID(moment time.Time) string {
+	var data [16]byte
+
+	milliseconds := uint64(moment.UnixMilli())
+	for index := 5; index >= 0; index-- {
+		data[index] = byte(milliseconds)
+		milliseconds >>= 8
+	}
+
+	_, _ = rand.Read(data[6:])
+
+	id := make([]byte, 26)
+
+	// 128 bits are encoded as 26 characters of 5 bits, starting from the
+	// most significant ones, so the first character encodes only 3 bits.
+	for index := range id {
+		var value byte
+		for offset := 4; offset >= 0; offset-- {
+			value <<= 1
+
+			position := (25-index)*5 + offset
+			if position < 128 && data[15-position/8]&(1<<(position%8)) != 0 {
+				value |= 1
+			}
+		}
+
+		id[index] = crockford[value]
+	}
+
+	return string(id)
+}
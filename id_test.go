@@ -0,0 +1,42 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+func ExampleError_GetID() {
+	RecordID = true
+	defer func() {
+		RecordID = false
+	}()
+
+	inner := Errorf(errors.New("connection refused"), "can't connect")
+	outer := Errorf(inner, "can't process request").(Error)
+
+	fmt.Println(len(outer.GetID()))
+	fmt.Println(outer.GetID() == inner.(Error).GetID())
+
+	rendered := strings.Replace(outer.Error(), outer.GetID(), "<id>", -1)
+	fmt.Println(rendered)
+
+	// Output:
+	// 26
+	// true
+	// can't process request [error ref: <id>]
+	// └─ can't connect
+	//    └─ connection refused
+}
+
+func ExampleError_GetID_format() {
+	moment := time.UnixMilli(1469918176385)
+
+	fmt.Println(newID(moment)[:10])
+	fmt.Println(newID(time.UnixMilli(0))[:10])
+
+	// Output:
+	// 01ARYZ6S41
+	// 0000000000
+}
@@ -0,0 +1,187 @@
+package hierr
+
+import (
+	"context"
+	"errors"
+	"reflect"
+)
+
+// AnyIs returns true if any node of given error tree matches target
+// according to errors.Is(). Reasons, which are not errors, never match.
+//
+//	if hierr.AnyIs(err, syscall.ECONNREFUSED) {
+//		// at least one host refused connection
+//	}
+func AnyIs(err error, target error) bool {
+	return anyIs(err, target)
+}
+
+// IsCanceled returns true if any node of given error tree is
+// context.Canceled, so cancellation, which is buried under several wraps,
+// can be told apart from failures.
+func IsCanceled(err error) bool {
+	return anyIs(err, context.Canceled)
+}
+
+// IsDeadline returns true if any node of given error tree is
+// context.DeadlineExceeded.
+func IsDeadline(err error) bool {
+	return anyIs(err, context.DeadlineExceeded)
+}
+
+// EveryLeafIs returns true if every leaf of given error tree, returned by
+// Leaves(), matches target according to errors.Is(). Nil error never
+// matches.
+func EveryLeafIs(err error, target error) bool {
+	if err == nil {
+		return false
+	}
+
+	for _, leaf := range leaves(err) {
+		reason, ok := leaf.(error)
+		if !ok || !is(reason, target) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func anyIs(node NestedError, target error) bool {
+	if err, ok := node.(error); ok && is(err, target) {
+		return true
+	}
+
+	_, _, reasons := Decompose(node)
+	for _, reason := range reasons {
+		if anyIs(reason, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Is reports whether the error is the same as target, which is hierarchy
+// error. Unlike ==, nested reasons, which are lists, are compared element
+// by element, so sentinels, which are created by Push(), can be matched by
+// errors.Is() and AnyIs().
+func (err Error) Is(target error) bool {
+	sentinel, ok := target.(Error)
+	return ok && same(err, sentinel)
+}
+
+// is reports whether err matches target as errors.Is() does, but doesn't
+// panic if target is hierarchy error, which can't be compared using ==,
+// because it has list of nested reasons.
+func is(err error, target error) bool {
+	if isComparable(target) {
+		return errors.Is(err, target)
+	}
+
+	if matcher, ok := err.(interface{ Is(error) bool }); ok &&
+		matcher.Is(target) {
+		return true
+	}
+
+	switch wrapper := err.(type) {
+	case interface{ Unwrap() error }:
+		if inner := wrapper.Unwrap(); inner != nil {
+			return is(inner, target)
+		}
+
+	case interface{ Unwrap() []error }:
+		for _, inner := range wrapper.Unwrap() {
+			if inner != nil && is(inner, target) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isComparable returns true if given node can be compared using == without
+// panic.
+func isComparable(node NestedError) bool {
+	switch node := node.(type) {
+	case nil:
+		return true
+
+	case Error:
+		return isComparable(node.Nested)
+
+	case []NestedError:
+		return false
+
+	default:
+		return reflect.TypeOf(node).Comparable()
+	}
+}
+
+// same reports whether two nodes are the same as == does, but compares
+// lists of nested reasons element by element.
+func same(a, b NestedError) bool {
+	switch a := a.(type) {
+	case Error:
+		b, ok := b.(Error)
+		if !ok || !same(a.Nested, b.Nested) {
+			return false
+		}
+
+		a.Nested, b.Nested = nil, nil
+
+		return a == b
+
+	case []NestedError:
+		b, ok := b.([]NestedError)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		for index := range a {
+			if !same(a[index], b[index]) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return isComparable(a) && isComparable(b) && a == b
+}
+
+// AsAll returns every node of given error tree, which can be assigned to T
+// according to errors.As(), in order of descending into the tree, unlike
+// errors.As(), which finds only the first match along single chain.
+//
+//	for _, opErr := range hierr.AsAll[*net.OpError](err) {
+//		fmt.Println(opErr.Addr)
+//	}
+//
+// Nil is returned if T is neither interface nor implements error, since no
+// error can be assigned to it.
+func AsAll[T any](err error) []T {
+	kind := reflect.TypeFor[T]()
+	if kind.Kind() != reflect.Interface &&
+		!kind.Implements(reflect.TypeFor[error]()) {
+		return nil
+	}
+
+	found := []T{}
+	asAll(err, &found)
+
+	return found
+}
+
+func asAll[T any](node NestedError, found *[]T) {
+	var target T
+	if err, ok := node.(error); ok && errors.As(err, &target) {
+		*found = append(*found, target)
+	}
+
+	_, _, reasons := Decompose(node)
+	for _, reason := range reasons {
+		asAll(reason, found)
+	}
+}
@@ -0,0 +1,112 @@
+package hierr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+func ExampleAnyIs() {
+	err := Push(
+		"can't sync",
+		Errorf(os.ErrNotExist, "can't read node-a"),
+		Errorf(fmt.Errorf("read: %w", io.ErrUnexpectedEOF), "can't read node-b"),
+	)
+
+	fmt.Println(AnyIs(err, io.ErrUnexpectedEOF))
+	fmt.Println(AnyIs(err, os.ErrPermission))
+	fmt.Println(AnyIs(nil, os.ErrPermission))
+
+	// Output:
+	// true
+	// false
+	// false
+}
+
+func ExampleEveryLeafIs() {
+	refused := errors.New("connection refused")
+
+	testcases := []error{
+		Push(
+			"can't deploy",
+			Errorf(refused, "node-a"),
+			Errorf(fmt.Errorf("dial: %w", refused), "node-b"),
+		),
+		Push(
+			"can't deploy",
+			Errorf(refused, "node-a"),
+			Errorf("timeout", "node-b"),
+		),
+		nil,
+	}
+
+	for _, test := range testcases {
+		fmt.Println(EveryLeafIs(test, refused))
+	}
+
+	// Output:
+	// true
+	// false
+	// false
+}
+
+func ExampleAsAll() {
+	err := Push(
+		"can't sync",
+		Errorf(&os.PathError{Op: "open", Path: "/a", Err: os.ErrNotExist}, "node-a"),
+		Errorf(errors.New("timeout"), "node-b"),
+		Errorf(
+			fmt.Errorf("read: %w", &os.PathError{Op: "read", Path: "/c", Err: io.EOF}),
+			"node-c",
+		),
+	)
+
+	for _, pathErr := range AsAll[*os.PathError](err) {
+		fmt.Println(pathErr.Op, pathErr.Path)
+	}
+
+	fmt.Println(len(AsAll[*os.LinkError](err)))
+	fmt.Println(AsAll[int](err) == nil)
+
+	// Output:
+	// open /a
+	// read /c
+	// 0
+	// true
+}
+
+func ExampleIsCanceled() {
+	err := Errorf(
+		Errorf(fmt.Errorf("query: %w", context.Canceled), "can't load user"),
+		"can't handle request",
+	)
+
+	fmt.Println(IsCanceled(err))
+	fmt.Println(IsDeadline(err))
+	fmt.Println(IsDeadline(Push("can't sync", context.DeadlineExceeded)))
+
+	// Output:
+	// true
+	// false
+	// true
+}
+
+func ExampleError_Is() {
+	sentinel := Push("can't connect", errors.New("refused"), "node-a")
+
+	err := Errorf(sentinel, "can't deploy")
+	other := Push("can't connect", errors.New("refused"), "node-b")
+
+	fmt.Println(AnyIs(err, sentinel))
+	fmt.Println(AnyIs(other, sentinel))
+	fmt.Println(EveryLeafIs(Push("can't sync", sentinel), sentinel))
+	fmt.Println(len(err.(Error).PathTo(sentinel)))
+
+	// Output:
+	// true
+	// false
+	// false
+	// 2
+}
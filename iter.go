@@ -0,0 +1,72 @@
+package hierr
+
+import (
+	"iter"
+)
+
+// All returns iterator over error and all its nested reasons in order of
+// descending into the tree. Reasons, which are not hierr errors, are yielded
+// as synthetic errors with the same message, nested reasons and context
+// pairs. Context pairs are not yielded as separate nodes.
+//
+//	for node := range err.All() {
+//		fmt.Println(node.Message)
+//	}
+func (err Error) All() iter.Seq[Error] {
+	return func(yield func(Error) bool) {
+		for _, node := range err.AllWithDepth() {
+			if !yield(node) {
+				return
+			}
+		}
+	}
+}
+
+// AllWithDepth returns iterator as All() does, but yields depth of every
+// node as well, where depth of error itself is zero.
+func (err Error) AllWithDepth() iter.Seq2[int, Error] {
+	return func(yield func(int, Error) bool) {
+		descend(err, 0, yield)
+	}
+}
+
+func descend(node NestedError, depth int, yield func(int, Error) bool) bool {
+	if !yield(depth, asError(node)) {
+		return false
+	}
+
+	_, _, reasons := Decompose(node)
+	for _, reason := range reasons {
+		if !descend(reason, depth+1, yield) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// asError returns given node as hierr error. Nodes, which are not hierr
+// errors, are converted into synthetic errors with the same message, nested
+// reasons and context pairs.
+func asError(node NestedError) Error {
+	if err, ok := node.(Error); ok {
+		return err
+	}
+
+	message, fields, reasons := Decompose(node)
+
+	err := Error{
+		Message: message,
+	}
+
+	if len(reasons) > 0 || len(fields) > 0 {
+		nested := append([]NestedError{}, reasons...)
+		for _, field := range fields {
+			nested = append(nested, Context(field.Key, field.Value))
+		}
+
+		err.Nested = nested
+	}
+
+	return err
+}
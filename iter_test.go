@@ -0,0 +1,41 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+func ExampleError_All() {
+	err := Push(
+		"can't deploy",
+		Context(
+			Errorf(errors.New("connection refused"), "can't connect"),
+			Context("host", "node-a"),
+		),
+		"timeout",
+	).(Error)
+
+	for node := range err.All() {
+		fmt.Println(node.Message)
+	}
+
+	fmt.Println()
+
+	for depth, node := range err.AllWithDepth() {
+		fmt.Println(strings.Repeat("  ", depth) + node.Message)
+		if node.Message == "connection refused" {
+			break
+		}
+	}
+
+	// Output:
+	// can't deploy
+	// can't connect
+	// connection refused
+	// timeout
+	//
+	// can't deploy
+	//   can't connect
+	//     connection refused
+}
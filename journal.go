@@ -0,0 +1,112 @@
+package hierr
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// JournalMessage is a message of aggregated error, which is returned by
+// Journal.Error().
+var JournalMessage = "recorded errors"
+
+// JournalEntry represents error, which is recorded by journal.
+type JournalEntry struct {
+	// Time is a moment, when error is recorded.
+	Time time.Time
+
+	// Err is a recorded error.
+	Err error
+}
+
+// Journal is an in-memory journal, which keeps specified number of the most
+// recently recorded errors, so they can be queried or dumped into crash
+// reports. Journal is safe for concurrent use.
+type Journal struct {
+	mutex    sync.Mutex
+	capacity int
+	entries  []JournalEntry
+}
+
+var (
+	defaultJournal atomic.Pointer[Journal]
+
+	now = time.Now
+)
+
+// NewJournal returns journal, which keeps capacity most recent errors.
+func NewJournal(capacity int) *Journal {
+	return &Journal{capacity: capacity}
+}
+
+// SetJournal sets journal, which records every error, created by Errorf()
+// and other constructors of the package, including errors, which wrap
+// other errors. Nil journal disables recording.
+func SetJournal(target *Journal) {
+	defaultJournal.Store(target)
+}
+
+// Record records error, evicting the oldest one, if journal is full. Nil
+// errors are ignored.
+func (journal *Journal) Record(err error) {
+	if err == nil || journal.capacity <= 0 {
+		return
+	}
+
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+
+	journal.entries = append(journal.entries, JournalEntry{
+		Time: now(),
+		Err:  err,
+	})
+
+	if len(journal.entries) > journal.capacity {
+		journal.entries = append(
+			[]JournalEntry{},
+			journal.entries[len(journal.entries)-journal.capacity:]...,
+		)
+	}
+}
+
+// Entries returns recorded errors, where the oldest error goes first.
+func (journal *Journal) Entries() []JournalEntry {
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+
+	return append([]JournalEntry{}, journal.entries...)
+}
+
+// Query returns recorded errors, which have context pair with given key at
+// any level of the tree, where the oldest error goes first.
+func (journal *Journal) Query(key string) []JournalEntry {
+	entries := []JournalEntry{}
+
+	for _, entry := range journal.Entries() {
+		for _, field := range AllFields(entry.Err) {
+			if field.Key == key {
+				entries = append(entries, entry)
+				break
+			}
+		}
+	}
+
+	return entries
+}
+
+// Error returns single aggregated error with JournalMessage message, which
+// has every recorded error as reason, so journal can be dumped into crash
+// report. Nil is returned if there are no recorded errors.
+func (journal *Journal) Error() error {
+	entries := journal.Entries()
+	if len(entries) == 0 {
+		return nil
+	}
+
+	reasons := []NestedError{}
+	for _, entry := range entries {
+		reasons = append(reasons, entry.Err)
+	}
+
+	return Push(JournalMessage, reasons...)
+}
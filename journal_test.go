@@ -0,0 +1,46 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleJournal() {
+	journal := NewJournal(2)
+
+	journal.Record(errors.New("connection refused"))
+	journal.Record(Push("can't deploy", Context("host", "example.com")))
+	journal.Record(Errorf(errors.New("disk is full"), "can't write"))
+	journal.Record(nil)
+
+	fmt.Println(len(journal.Entries()))
+	fmt.Println(journal.Query("host")[0].Err.(Error).Message)
+	fmt.Println(journal.Error())
+
+	// Output:
+	// 2
+	// can't deploy
+	// recorded errors
+	// ├─ can't deploy
+	// │  └─ host
+	// │     └─ example.com
+	// │
+	// └─ can't write
+	//    └─ disk is full
+}
+
+func ExampleSetJournal() {
+	journal := NewJournal(10)
+
+	SetJournal(journal)
+	defer SetJournal(nil)
+
+	_ = Errorf(errors.New("disk is full"), "can't write")
+
+	fmt.Println(journal.Error())
+
+	// Output:
+	// recorded errors
+	// └─ can't write
+	//    └─ disk is full
+}
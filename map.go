@@ -0,0 +1,63 @@
+package hierr
+
+// Map returns new error tree, where every node is replaced with result of
+// given mapper, so messages and context pairs can be redacted, translated or
+// shortened without changing structure of the tree.
+//
+// Mapper is called for node before its nested reasons, reasons of returned
+// node are mapped afterwards. Reasons, which are not hierr errors, are
+// passed to mapper as synthetic errors, as All() does. Such reasons are
+// replaced with hierr errors in the resulting tree only if mapper changes
+// them, so reasons, which are left as is, still can be matched by
+// errors.Is() and errors.As(). Members of multi-error containers, like
+// errors.Join(), are mapped one by one and are kept as sibling reasons.
+func (err Error) Map(mapper func(Error) Error) Error {
+	return mapChildren(mapper(err), mapper)
+}
+
+func mapNode(node NestedError, mapper func(Error) Error) NestedError {
+	if _, ok := node.(Error); !ok {
+		if _, fields, reasons := Decompose(node); len(fields) == 0 &&
+			len(reasons) == 0 {
+			leaf := asError(node)
+
+			mapped := mapper(leaf)
+			if same(mapped, leaf) {
+				return node
+			}
+
+			return mapChildren(mapped, mapper)
+		}
+	}
+
+	return mapChildren(mapper(asError(node)), mapper)
+}
+
+func mapChildren(mapped Error, mapper func(Error) Error) Error {
+	if mapped.Nested == nil {
+		return mapped
+	}
+
+	if _, ok := mapped.Nested.([]NestedError); !ok {
+		if _, ok := multiErrors(mapped.Nested); !ok {
+			if _, ok := getField(mapped.Nested); !ok {
+				mapped.Nested = mapNode(mapped.Nested, mapper)
+			}
+
+			return mapped
+		}
+	}
+
+	children := []NestedError{}
+	for _, child := range mapped.GetNested() {
+		if _, ok := getField(child); !ok {
+			child = mapNode(child, mapper)
+		}
+
+		children = append(children, child)
+	}
+
+	mapped.Nested = children
+
+	return mapped
+}
@@ -0,0 +1,90 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+func ExampleError_Map() {
+	err := Push(
+		"can't deploy to /home/user/app",
+		Context(
+			Errorf(errors.New("unauthorized"), "can't login"),
+			Context("token", "secret"),
+		),
+		"timeout",
+	).(Error)
+
+	redacted := err.Map(func(node Error) Error {
+		node.Message = strings.Replace(node.Message, "/home/user", "~", -1)
+
+		if _, ok := node.GetValue("token"); ok {
+			node = node.WithValue("token", "<redacted>")
+		}
+
+		return node
+	})
+
+	fmt.Println(redacted)
+	fmt.Println()
+	fmt.Println(err)
+
+	// Output:
+	// can't deploy to ~/app
+	// ├─ can't login
+	// │  ├─ unauthorized
+	// │  │
+	// │  └─ token
+	// │     └─ <redacted>
+	// │
+	// └─ timeout
+	//
+	// can't deploy to /home/user/app
+	// ├─ can't login
+	// │  ├─ unauthorized
+	// │  │
+	// │  └─ token
+	// │     └─ secret
+	// │
+	// └─ timeout
+}
+
+func ExampleError_Map_singleContext() {
+	err := Error{Message: "can't connect", Nested: Context("host", "example.com")}
+
+	fmt.Println(err.Map(func(node Error) Error {
+		node.Message = strings.ToUpper(node.Message)
+		return node
+	}))
+
+	// Output:
+	// CAN'T CONNECT
+	// └─ host
+	//    └─ example.com
+}
+
+func ExampleError_Map_identity() {
+	err := Errorf(
+		errors.Join(io.EOF, Errorf(io.ErrUnexpectedEOF, "can't read")),
+		"can't sync",
+	).(Error)
+
+	mapped := err.Map(func(node Error) Error {
+		return node
+	})
+
+	fmt.Println(mapped)
+	fmt.Println(Equal(mapped, err), mapped.Error() == err.Error())
+	fmt.Println(AnyIs(mapped, io.EOF), AnyIs(mapped, io.ErrUnexpectedEOF))
+
+	// Output:
+	// can't sync
+	// ├─ EOF
+	// │
+	// └─ can't read
+	//    └─ unexpected EOF
+	// true true
+	// true true
+}
@@ -0,0 +1,87 @@
+package hierr
+
+// MergeMessage set message of common root, which is created by Merge(), if
+// merged errors have different top-level messages.
+var MergeMessage = "multiple errors occurred"
+
+// Merge combines two error trees into one. If errors have the same top-level
+// message, they are merged into single node, otherwise they are placed under
+// common root with MergeMessage.
+//
+// When nodes are merged, their reasons with the same messages are merged
+// recursively, other reasons are kept in order, and context pairs are
+// unioned, so pairs with the same key and value are reported only once.
+// Nil errors are ignored.
+func Merge(a, b error) error {
+	switch {
+	case a == nil:
+		return b
+
+	case b == nil:
+		return a
+	}
+
+	message, _, _ := Decompose(a)
+	if other, _, _ := Decompose(b); other != message {
+		return Push(MergeMessage, a, b)
+	}
+
+	return merge(a, b).(error)
+}
+
+func merge(a, b NestedError) NestedError {
+	var (
+		_, oldFields, oldReasons = Decompose(a)
+		_, newFields, newReasons = Decompose(b)
+	)
+
+	if len(oldReasons) == 0 && len(newReasons) == 0 &&
+		len(oldFields) == 0 && len(newFields) == 0 {
+		return a
+	}
+
+	matched := make([]bool, len(newReasons))
+
+	nested := []NestedError{}
+	for _, oldReason := range oldReasons {
+		message, _, _ := Decompose(oldReason)
+
+		for index, newReason := range newReasons {
+			if matched[index] {
+				continue
+			}
+
+			if candidate, _, _ := Decompose(newReason); candidate == message {
+				matched[index] = true
+				oldReason = merge(oldReason, newReason)
+
+				break
+			}
+		}
+
+		nested = append(nested, oldReason)
+	}
+
+	for index, newReason := range newReasons {
+		if !matched[index] {
+			nested = append(nested, newReason)
+		}
+	}
+
+	seen := map[Field]bool{}
+	for _, field := range append(oldFields, newFields...) {
+		key := Field{Key: field.Key, Value: String(field.Value)}
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		nested = append(nested, Context(field.Key, field.Value))
+	}
+
+	err := asError(a)
+	err.Nested = nested
+
+	return err
+}
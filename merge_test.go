@@ -0,0 +1,59 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleMerge() {
+	first := Context(
+		Push(
+			"can't deploy",
+			Context(
+				Errorf(errors.New("connection refused"), "can't connect"),
+				Context("host", "node-a"),
+			),
+		),
+		Context("attempt", 1),
+	)
+
+	second := Context(
+		Push(
+			"can't deploy",
+			Context(
+				Errorf(errors.New("connection refused"), "can't connect"),
+				Context("host", "node-a"),
+			),
+			errors.New("disk is full"),
+		),
+		Context("attempt", 2),
+	)
+
+	fmt.Println(Merge(first, second))
+	fmt.Println()
+	fmt.Println(Merge(errors.New("timeout"), errors.New("canceled")))
+	fmt.Println()
+	fmt.Println(Merge(nil, errors.New("timeout")))
+
+	// Output:
+	// can't deploy
+	// ├─ can't connect
+	// │  ├─ connection refused
+	// │  │
+	// │  └─ host
+	// │     └─ node-a
+	// │
+	// ├─ disk is full
+	// │
+	// ├─ attempt
+	// │  └─ 1
+	// │
+	// └─ attempt
+	//    └─ 2
+	//
+	// multiple errors occurred
+	// ├─ timeout
+	// └─ canceled
+	//
+	// timeout
+}
@@ -0,0 +1,72 @@
+package hierr
+
+import (
+	"strings"
+)
+
+// multiErrors returns errors, which are contained in given multi-error
+// container, like *multierror.Error of github.com/hashicorp/go-multierror
+// or error of go.uber.org/multierr, which are recognized by their methods
+// WrappedErrors() and Errors() respectively, or error, returned by
+// errors.Join().
+func multiErrors(node NestedError) ([]error, bool) {
+	if _, ok := node.(HierarchicalError); ok {
+		return nil, false
+	}
+
+	switch container := node.(type) {
+	case interface{ WrappedErrors() []error }:
+		return container.WrappedErrors(), true
+
+	case interface{ Errors() []error }:
+		return container.Errors(), true
+
+	case interface{ Unwrap() []error }:
+		err, ok := container.(error)
+		if ok && isJoined(err, container.Unwrap()) {
+			return container.Unwrap(), true
+		}
+	}
+
+	return nil, false
+}
+
+// isJoined returns true if message of given error consists of messages of
+// wrapped errors, separated by newlines, as message of errors.Join() does.
+// Other errors, which wrap multiple errors, like errors of fmt.Errorf() with
+// several %w verbs, have own messages and are not expanded.
+func isJoined(err error, errs []error) bool {
+	messages := []string{}
+	for _, nested := range errs {
+		if nested != nil {
+			messages = append(messages, nested.Error())
+		}
+	}
+
+	return err.Error() == strings.Join(messages, "\n")
+}
+
+// expand returns copy of given children, where multi-error containers are
+// replaced with errors, which they contain, so these errors are reported as
+// sibling reasons. Nil errors are skipped.
+func expand(children []NestedError) []NestedError {
+	expanded := []NestedError{}
+	for _, child := range children {
+		errs, ok := multiErrors(child)
+		if !ok {
+			expanded = append(expanded, child)
+			continue
+		}
+
+		nested := []NestedError{}
+		for _, err := range errs {
+			if err != nil {
+				nested = append(nested, err)
+			}
+		}
+
+		expanded = append(expanded, expand(nested)...)
+	}
+
+	return expanded
+}
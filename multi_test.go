@@ -0,0 +1,77 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// hashicorpError mimics *multierror.Error of github.com/hashicorp/go-multierror.
+type hashicorpError struct {
+	errors []error
+}
+
+func (err *hashicorpError) WrappedErrors() []error {
+	return err.errors
+}
+
+func (err *hashicorpError) Error() string {
+	return fmt.Sprintf("%d errors occurred", len(err.errors))
+}
+
+// uberError mimics error of go.uber.org/multierr.
+type uberError []error
+
+func (err uberError) Errors() []error {
+	return err
+}
+
+func (err uberError) Error() string {
+	messages := []string{}
+	for _, err := range err {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func Example_multiError() {
+	fmt.Println(Errorf(
+		&hashicorpError{errors: []error{
+			errors.New("connection refused"),
+			nil,
+			uberError{errors.New("disk is full"), errors.New("permission denied")},
+		}},
+		"can't deploy",
+	))
+
+	fmt.Println(len(Errorf(uberError{errors.New("timeout")}, "can't sync").(Error).Leaves()))
+
+	// Output:
+	// can't deploy
+	// ├─ connection refused
+	// ├─ disk is full
+	// └─ permission denied
+	// 1
+}
+
+func Example_join() {
+	fmt.Println(Errorf(
+		errors.Join(errors.New("connection refused"), errors.New("disk is full")),
+		"can't deploy",
+	))
+
+	fmt.Println(Errorf(
+		fmt.Errorf(
+			"%w and %w", errors.New("connection refused"), errors.New("timeout"),
+		),
+		"can't sync",
+	))
+
+	// Output:
+	// can't deploy
+	// ├─ connection refused
+	// └─ disk is full
+	// can't sync
+	// └─ connection refused and timeout
+}
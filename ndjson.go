@@ -0,0 +1,80 @@
+package hierr
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// ndjsonNode represents node of error tree, which is written by
+// WriteNDJSON().
+type ndjsonNode struct {
+	Index   int           `json:"index"`
+	Parent  int           `json:"parent"`
+	Depth   int           `json:"depth"`
+	Message string        `json:"message"`
+	Context []ndjsonField `json:"context,omitempty"`
+}
+
+type ndjsonField struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// WriteNDJSON writes error tree as newline-delimited JSON, one object per
+// node in order of descending into the tree, so huge aggregated trees can
+// be streamed to analysis tools without building one document in memory:
+//
+//	{"index":0,"parent":-1,"depth":0,"message":"can't deploy"}
+//	{"index":1,"parent":0,"depth":1,"message":"can't connect","context":[{"key":"host","value":"example.com"}]}
+//
+// Index is a number of node in the stream, parent is an index of parent
+// node, which is -1 for error itself. Values of context pairs are converted
+// to strings. Nothing is written for nil error.
+func WriteNDJSON(writer io.Writer, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	index := 0
+
+	return writeNDJSON(json.NewEncoder(writer), err, -1, 0, &index)
+}
+
+func writeNDJSON(
+	encoder *json.Encoder,
+	node NestedError,
+	parent int,
+	depth int,
+	index *int,
+) error {
+	message, fields, reasons := Decompose(node)
+
+	encoded := ndjsonNode{
+		Index:   *index,
+		Parent:  parent,
+		Depth:   depth,
+		Message: message,
+	}
+
+	for _, field := range fields {
+		encoded.Context = append(encoded.Context, ndjsonField{
+			Key:   field.Key,
+			Value: String(field.Value),
+		})
+	}
+
+	if err := encoder.Encode(encoded); err != nil {
+		return Errorf(err, "can't write node %d", encoded.Index)
+	}
+
+	*index++
+
+	for _, reason := range reasons {
+		err := writeNDJSON(encoder, reason, encoded.Index, depth+1, index)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package hierr
+
+import (
+	"errors"
+	"os"
+)
+
+func ExampleWriteNDJSON() {
+	err := Push(
+		"can't deploy",
+		Push(
+			"can't connect",
+			errors.New("connection refused"),
+			Context("host", "example.com"),
+		),
+		errors.New("disk is full"),
+	)
+
+	WriteNDJSON(os.Stdout, err)
+
+	// Output:
+	// {"index":0,"parent":-1,"depth":0,"message":"can't deploy"}
+	// {"index":1,"parent":0,"depth":1,"message":"can't connect","context":[{"key":"host","value":"example.com"}]}
+	// {"index":2,"parent":1,"depth":2,"message":"connection refused"}
+	// {"index":3,"parent":0,"depth":1,"message":"disk is full"}
+}
@@ -0,0 +1,83 @@
+package hierr
+
+// Option configures hierarchy error, which is created by NewWith().
+type Option func(*Builder)
+
+// NewWith creates new hierarchy error with given message, which is
+// configured by specified options:
+//
+//	hierr.NewWith(
+//		"can't connect",
+//		hierr.WithReason(err),
+//		hierr.WithContext("host", host),
+//		hierr.WithStack(),
+//	)
+//
+// It's named NewWith, because New() is already used by non-formatting
+// variant of Errorf().
+func NewWith(message string, options ...Option) error {
+	builder := Build(message)
+	for _, option := range options {
+		option(builder)
+	}
+
+	return builder.build(1)
+}
+
+// WithReason adds given reason to the error, nil reasons are ignored.
+func WithReason(reason NestedError) Option {
+	return func(builder *Builder) {
+		builder.Reason(reason)
+	}
+}
+
+// WithContext adds context pair with given key and value to the error.
+func WithContext(key string, value interface{}) Option {
+	return func(builder *Builder) {
+		builder.Context(key, value)
+	}
+}
+
+// WithCode adds context pair with CodeKey key and given code to the error.
+func WithCode(code interface{}) Option {
+	return func(builder *Builder) {
+		builder.Code(code)
+	}
+}
+
+// WithStack enables capturing of call stack of the caller of NewWith().
+func WithStack() Option {
+	return func(builder *Builder) {
+		builder.Stack()
+	}
+}
+
+// WithSeverity sets severity of the error.
+func WithSeverity(severity Severity) Option {
+	return func(builder *Builder) {
+		builder.Severity(severity)
+	}
+}
+
+// WithRetryable marks the error as retryable or not by adding context pair
+// with RetryableKey key.
+func WithRetryable(retryable bool) Option {
+	return func(builder *Builder) {
+		builder.Retryable(retryable)
+	}
+}
+
+// WithTags adds given tags to the error.
+func WithTags(tags ...string) Option {
+	return func(builder *Builder) {
+		builder.Tags(tags...)
+	}
+}
+
+// WithAttachment attaches named payload, like captured stderr, to the
+// error.
+func WithAttachment(name string, data []byte) Option {
+	return func(builder *Builder) {
+		builder.Attach(name, data)
+	}
+}
@@ -0,0 +1,30 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleNewWith() {
+	err := NewWith(
+		"can't connect",
+		WithReason(errors.New("connection refused")),
+		WithContext("host", "example.com"),
+		WithCode(42),
+		WithStack(),
+	)
+
+	fmt.Println(err)
+	fmt.Println(err.(Error).Stack.Frames()[0].Function)
+
+	// Output:
+	// can't connect
+	// ├─ connection refused
+	// │
+	// ├─ host
+	// │  └─ example.com
+	// │
+	// └─ code
+	//    └─ 42
+	// github.com/reconquest/hierr-go.ExampleNewWith
+}
@@ -0,0 +1,135 @@
+package hierr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Parse reconstructs hierarchy error from its textual representation, which
+// is produced by Error(), using either BranchDelimiterBox, BranchChainerBox
+// and BranchSplitterBox or their ASCII variants, and default BranchIndent,
+// so errors, which are found in logs, can be re-rendered, filtered or
+// converted.
+//
+// Lines, which precede the first branch of node, are continuation of its
+// message, so multi-line messages, like captured stderr or output of
+// errors.Join(), are reconstructed as is.
+//
+// Textual representation doesn't distinguish context pairs from reasons, so
+// context pairs are reconstructed as nodes with single reason. Parsed nodes
+// have nested reasons as []NestedError, terminal nodes have no nested
+// reasons.
+func Parse(text string) (Error, error) {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) == "" {
+		return Error{}, fmt.Errorf("can't parse error tree: text is empty")
+	}
+
+	return parseNode(lines, 1)
+}
+
+var (
+	branchMarkers = []string{
+		BranchSplitterBox, BranchDelimiterBox,
+		BranchSplitterASCII, BranchDelimiterASCII,
+	}
+
+	chainMarkers = []string{"│", "|", " "}
+)
+
+// parseNode parses node, which message is the first line, and which
+// children are rendered in the rest of lines.
+func parseNode(lines []string, line int) (Error, error) {
+	err := Error{Message: lines[0]}
+
+	var (
+		children = []NestedError{}
+		child    []string
+		start    int
+	)
+
+	flush := func() error {
+		if child == nil {
+			return nil
+		}
+
+		node, err := parseNode(child, start)
+		if err != nil {
+			return err
+		}
+
+		children = append(children, node)
+		child = nil
+
+		return nil
+	}
+
+	for index, text := range lines[1:] {
+		number := line + index + 1
+
+		if marker, ok := branchMarker(text); ok {
+			if err := flush(); err != nil {
+				return Error{}, err
+			}
+
+			child = []string{strings.TrimPrefix(text, marker)}
+			start = number
+
+			continue
+		}
+
+		if child == nil && len(children) == 0 {
+			err.Message += "\n" + text
+			continue
+		}
+
+		if child == nil || !isChained(text) {
+			return Error{}, fmt.Errorf(
+				"can't parse error tree: unexpected line %d: %q", number, text,
+			)
+		}
+
+		// Lines, which are shorter than indent, contain only chainer and
+		// separate branches.
+		runes := []rune(text)
+		if len(runes) < BranchIndent {
+			continue
+		}
+
+		child = append(child, string(runes[BranchIndent:]))
+	}
+
+	if err := flush(); err != nil {
+		return Error{}, err
+	}
+
+	if len(children) > 0 {
+		err.Nested = children
+	}
+
+	return err, nil
+}
+
+func branchMarker(text string) (string, bool) {
+	for _, marker := range branchMarkers {
+		if strings.HasPrefix(text, marker) {
+			return marker, true
+		}
+	}
+
+	return "", false
+}
+
+func isChained(text string) bool {
+	if text == "" {
+		return true
+	}
+
+	for _, marker := range chainMarkers {
+		if strings.HasPrefix(text, marker) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,76 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleParse() {
+	original := Push(
+		"can't deploy",
+		Context(
+			Errorf(errors.New("connection refused"), "can't connect"),
+			Context("host", "example.com"),
+		),
+		errors.New("disk is full"),
+	)
+
+	parsed, err := Parse(original.Error())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(parsed)
+	fmt.Println(parsed.Error() == original.Error())
+	fmt.Println(parsed.Count())
+
+	BranchDelimiter = BranchDelimiterASCII
+	BranchSplitter = BranchSplitterASCII
+	BranchChainer = BranchChainerASCII
+
+	ascii := original.Error()
+
+	BranchDelimiter = BranchDelimiterBox
+	BranchSplitter = BranchSplitterBox
+	BranchChainer = BranchChainerBox
+
+	parsed, err = Parse(ascii)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(parsed.Error() == original.Error())
+
+	multiline := Push(
+		"can't run",
+		errors.New("line one\nline two"),
+		Errorf(errors.Join(errors.New("a"), errors.New("b")), "can't\nmerge"),
+	)
+
+	parsed, err = Parse(multiline.Error())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(parsed.Error() == multiline.Error())
+	fmt.Printf("%q\n", parsed.GetNested()[0].(Error).GetMessage())
+
+	_, err = Parse("can't deploy\n└─ connection refused\ntimeout")
+	fmt.Println(err)
+
+	// Output:
+	// can't deploy
+	// ├─ can't connect
+	// │  ├─ connection refused
+	// │  │
+	// │  └─ host
+	// │     └─ example.com
+	// │
+	// └─ disk is full
+	// true
+	// 6
+	// true
+	// true
+	// "line one\nline two"
+	// can't parse error tree: unexpected line 3: "timeout"
+}
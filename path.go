@@ -0,0 +1,36 @@
+package hierr
+
+// Path returns chain of nodes from the error itself to the first node of the
+// tree, matching given predicate, or nil if there is no such node. Nodes are
+// passed to predicate in order of descending into the tree. Reasons, which
+// are not hierr errors, are returned as synthetic errors, as All() does.
+func (err Error) Path(predicate func(Error) bool) []Error {
+	return path(err, func(node NestedError) bool {
+		return predicate(asError(node))
+	})
+}
+
+// PathTo returns chain of nodes from the error itself to the first node of
+// the tree, which matches target according to errors.Is(), or nil if there
+// is no such node.
+func (err Error) PathTo(target error) []Error {
+	return path(err, func(node NestedError) bool {
+		reason, ok := node.(error)
+		return ok && is(reason, target)
+	})
+}
+
+func path(node NestedError, match func(NestedError) bool) []Error {
+	if match(node) {
+		return []Error{asError(node)}
+	}
+
+	_, _, reasons := Decompose(node)
+	for _, reason := range reasons {
+		if tail := path(reason, match); tail != nil {
+			return append([]Error{asError(node)}, tail...)
+		}
+	}
+
+	return nil
+}
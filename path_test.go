@@ -0,0 +1,40 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+func ExampleError_Path() {
+	refused := errors.New("connection refused")
+
+	err := Push(
+		"can't deploy",
+		Errorf(errors.New("not found"), "can't get release"),
+		Errorf(
+			Errorf(refused, "can't connect to database"),
+			"can't run migrations",
+		),
+	).(Error)
+
+	breadcrumbs := func(path []Error) string {
+		messages := []string{}
+		for _, node := range path {
+			messages = append(messages, node.Message)
+		}
+
+		return strings.Join(messages, " > ")
+	}
+
+	fmt.Println(breadcrumbs(err.PathTo(refused)))
+	fmt.Println(breadcrumbs(err.Path(func(node Error) bool {
+		return node.Message == "not found"
+	})))
+	fmt.Println(err.PathTo(errors.New("other")) == nil)
+
+	// Output:
+	// can't deploy > can't run migrations > can't connect to database > connection refused
+	// can't deploy > can't get release > not found
+	// true
+}
@@ -0,0 +1,48 @@
+package hierr
+
+import (
+	"errors"
+)
+
+// AsError returns the first hierarchy error in the chain of given error, as
+// reported by errors.As(), and false if there is no such error. Both values
+// and non-nil pointers to hierarchy errors in the chain are found:
+//
+//	if hierarchical, ok := hierr.AsError(err); ok {
+//		fmt.Println(hierarchical.GetMessage())
+//	}
+//
+// Error stays a value type, which methods have value receivers, so values
+// keep implementing error interface; pointers to hierarchy errors,
+// including nil ones, are accepted as nodes of the tree instead. Methods
+// are not nil-safe, use AsError() to get value, which methods can be
+// called on, from pointer, which may be nil.
+func AsError(err error) (Error, bool) {
+	var value Error
+	if errors.As(err, &value) {
+		return value, true
+	}
+
+	var pointer *Error
+	if errors.As(err, &pointer) && pointer != nil {
+		return *pointer, true
+	}
+
+	return Error{}, false
+}
+
+// dereference returns hierarchy error, referenced by given pointer, or nil
+// if pointer is nil, other nodes are returned as is. Pointers to hierarchy
+// errors are accepted everywhere instead of values, so nil pointers are not
+// dereferenced by value receivers.
+func dereference(node NestedError) NestedError {
+	if pointer, ok := node.(*Error); ok {
+		if pointer == nil {
+			return nil
+		}
+
+		return *pointer
+	}
+
+	return node
+}
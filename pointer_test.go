@@ -0,0 +1,65 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleAsError() {
+	err := fmt.Errorf(
+		"can't sync: %w",
+		Errorf(errors.New("exit status 128"), "can't pull"),
+	)
+
+	hierarchical, ok := AsError(err)
+	fmt.Println(hierarchical.GetMessage(), ok)
+
+	var missing *Error
+
+	_, ok = AsError(missing)
+	fmt.Println(ok)
+
+	plain, ok := AsError(errors.New("timeout"))
+	fmt.Printf("%q %v\n", plain.Error(), ok)
+
+	// Output:
+	// can't pull true
+	// false
+	// "" false
+}
+
+func ExamplePlainErrors() {
+	defer func() {
+		PlainErrors = false
+	}()
+
+	PlainErrors = true
+
+	_, hierarchical := Errorf(nil, "timeout").(Error)
+	fmt.Println(hierarchical)
+
+	_, hierarchical = Errorf(errors.New("timeout"), "can't connect").(Error)
+	fmt.Println(hierarchical)
+
+	// Output:
+	// false
+	// true
+}
+
+func Example_pointer() {
+	var missing *Error
+
+	err := &Error{
+		Message: "can't connect",
+		Nested:  []NestedError{missing, errors.New("timeout")},
+	}
+
+	fmt.Println(err)
+	fmt.Println(String(missing))
+
+	// Output:
+	// can't connect
+	// ├─ <nil>
+	// └─ timeout
+	// <nil>
+}
@@ -0,0 +1,56 @@
+package hierr
+
+// Prune returns new error tree without nodes, matching given predicate, and
+// their nested reasons. Error itself is never removed. Context pairs are not
+// passed to predicate and are never removed.
+//
+// Reasons are passed to predicate as synthetic errors, as All() does, but
+// reasons, which are kept, are left as is, so they still can be matched by
+// errors.Is() and errors.As(). Nested hierarchical errors, which are not
+// hierr errors, are converted into hierr errors. Members of multi-error
+// containers, like errors.Join(), are pruned one by one and are kept as
+// sibling reasons.
+func (err Error) Prune(predicate func(Error) bool) Error {
+	return pruneNode(err, predicate)
+}
+
+func pruneNode(err Error, predicate func(Error) bool) Error {
+	prune := func(child NestedError) (NestedError, bool) {
+		if _, ok := getField(child); ok {
+			return child, true
+		}
+
+		if predicate(asError(child)) {
+			return nil, false
+		}
+
+		if _, ok := child.(HierarchicalError); ok {
+			return pruneNode(asError(child), predicate), true
+		}
+
+		return child, true
+	}
+
+	if err.Nested == nil {
+		return err
+	}
+
+	if _, ok := err.Nested.([]NestedError); !ok {
+		if _, ok := multiErrors(err.Nested); !ok {
+			err.Nested, _ = prune(err.Nested)
+
+			return err
+		}
+	}
+
+	children := []NestedError{}
+	for _, child := range err.GetNested() {
+		if child, ok := prune(child); ok {
+			children = append(children, child)
+		}
+	}
+
+	err.Nested = children
+
+	return err
+}
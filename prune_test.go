@@ -0,0 +1,65 @@
+package hierr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+func ExampleError_Prune() {
+	err := Push(
+		"can't deploy",
+		Context(
+			Errorf(context.Canceled, "can't deploy to node-a"),
+			Context("host", "node-a"),
+		),
+		Context(
+			Errorf(errors.New("disk is full"), "can't deploy to node-b"),
+			Context("host", "node-b"),
+		),
+		Errorf(Errorf(context.Canceled, "can't upload"), "can't deploy to node-c"),
+	).(Error)
+
+	canceled := func(node Error) bool {
+		return len(node.Find(func(node Error) bool {
+			return node.Message == context.Canceled.Error()
+		})) > 0
+	}
+
+	fmt.Println(err.Prune(canceled))
+
+	// Output:
+	// can't deploy
+	// └─ can't deploy to node-b
+	//    ├─ disk is full
+	//    │
+	//    └─ host
+	//       └─ node-b
+}
+
+func ExampleError_Prune_multiError() {
+	err := Push(
+		"can't deploy",
+		Errorf(
+			errors.Join(context.Canceled, errors.New("disk is full")),
+			"can't deploy to node-a",
+		),
+		&hashicorpError{errors: []error{
+			errors.New("permission denied"),
+			context.Canceled,
+		}},
+	).(Error)
+
+	canceled := func(node Error) bool {
+		return node.Message == context.Canceled.Error()
+	}
+
+	fmt.Println(err.Prune(canceled))
+
+	// Output:
+	// can't deploy
+	// ├─ can't deploy to node-a
+	// │  └─ disk is full
+	// │
+	// └─ permission denied
+}
@@ -0,0 +1,104 @@
+package hierr
+
+// PublicFallbackMessage is a message, which is shown by RenderPublic() if
+// top-level error is not public.
+var PublicFallbackMessage = "internal error"
+
+// WithPublicMessage returns copy of the error with given public message,
+// which is safe to show to API clients and end-users, while Message keeps
+// internal details for logs.
+func (err Error) WithPublicMessage(message string) Error {
+	err.PublicMessage = message
+
+	return err
+}
+
+// GetPublicMessage returns public message of the error, empty string is
+// returned if it's not set.
+func (err Error) GetPublicMessage() string {
+	return err.PublicMessage
+}
+
+// RenderPublic renders safe summary of error, which contains only public
+// nodes, so APIs and CLIs can show it, while logs keep the whole tree:
+//
+//	err := hierr.Errorf(dbErr, "can't insert into users").(hierr.Error).
+//		WithPublicMessage("can't create account")
+//
+//	hierr.RenderPublic(err) // can't create account
+//
+// Node is public if it has public message or VisibilityPublic, unless it has
+// VisibilityPrivate, public nodes are shown with their public messages, if
+// they're set. Public nested reasons of nodes, which are not public, are
+// shown in their place, nested reasons of private nodes are omitted. Context
+// pairs are shown only if their keys are made public by SetKeyVisibility().
+// Caller locations are omitted, while IDs and hints of public nodes are
+// kept. If top-level error is not public, PublicFallbackMessage is used.
+// Empty string is returned for nil error.
+func RenderPublic(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	root := Error{Message: PublicFallbackMessage}
+	if node, ok := dereference(err).(Error); ok && isPublic(node) {
+		root = publicNode(node)
+	} else if !ok || node.Visibility != VisibilityPrivate {
+		root.Nested = publicReasons(err)
+	}
+
+	options := newRendering(false)
+	options.callers = false
+
+	return root.format(options)
+}
+
+func publicNode(err Error) Error {
+	message := err.Message
+	if err.PublicMessage != "" {
+		message = err.PublicMessage
+	}
+
+	node := Error{
+		Message:  message,
+		ID:       err.ID,
+		Severity: err.Severity,
+		hints:    err.hints,
+	}
+
+	nested := publicReasons(err)
+	for _, field := range err.GetContext() {
+		if GetKeyVisibility(field.Key) == VisibilityPublic {
+			nested = append(nested, Context(field.Key, field.Value))
+		}
+	}
+
+	if len(nested) > 0 {
+		node.Nested = nested
+	}
+
+	return node
+}
+
+// publicReasons returns public nodes of reasons of given node, reasons,
+// which are not public, are replaced with their public reasons, unless
+// they're private.
+func publicReasons(node NestedError) []NestedError {
+	_, _, reasons := Decompose(node)
+
+	public := []NestedError{}
+	for _, reason := range reasons {
+		err, ok := dereference(reason).(Error)
+		switch {
+		case ok && isPublic(err):
+			public = append(public, publicNode(err))
+
+		case ok && err.Visibility == VisibilityPrivate:
+
+		default:
+			public = append(public, publicReasons(reason)...)
+		}
+	}
+
+	return public
+}
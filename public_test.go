@@ -0,0 +1,29 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleRenderPublic() {
+	err := Push(
+		Errorf(
+			Errorf(
+				errors.New("duplicate key value violates unique constraint"),
+				"can't insert into users",
+			).(Error).WithPublicMessage("account already exists").
+				WithHint("try to sign in instead"),
+			"can't execute transaction",
+		),
+		Context("dsn", "postgres://admin:secret@db"),
+	).(Error).WithPublicMessage("can't create account")
+
+	fmt.Println(RenderPublic(err))
+	fmt.Println(RenderPublic(errors.New("connection refused")))
+
+	// Output:
+	// can't create account
+	// └─ account already exists
+	//    └─ hint: try to sign in instead
+	// internal error
+}
@@ -0,0 +1,40 @@
+package hierr
+
+import (
+	"errors"
+)
+
+// Recover converts panic into hierarchy error with given message, which
+// reason is panic value, and stores it into error, referenced by given
+// pointer. It must be called directly by defer statement:
+//
+//	func handle(request *http.Request) (err error) {
+//		defer hierr.Recover(&err, "can't handle request")
+//		...
+//	}
+//
+// Call stack of panic is captured and displayed when error is printed using
+// %+v verb. If there is no panic, error is not modified.
+func Recover(err *error, message string) {
+	value := recover()
+	if value == nil {
+		return
+	}
+
+	recovered := panicError(2, value, message)
+	if err != nil {
+		*err = recovered
+	}
+}
+
+// panicError creates hierarchy error with given message, which reason is
+// panic value, capturing call stack, starting from specified number of
+// frames above the caller of panicError.
+func panicError(skip int, value interface{}, message string) error {
+	reason, ok := value.(error)
+	if !ok {
+		reason = errors.New(String(value))
+	}
+
+	return newError(skip+1, true, reason, message)
+}
@@ -0,0 +1,34 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+func ExampleRecover() {
+	handle := func(value interface{}) (err error) {
+		defer Recover(&err, "can't handle request")
+
+		if value != nil {
+			panic(value)
+		}
+
+		return nil
+	}
+
+	fmt.Println(handle(nil))
+	fmt.Println(handle("index out of range"))
+	fmt.Println(handle(errors.New("nil map")))
+
+	err := handle("boom").(Error)
+	fmt.Println(strings.Contains(err.Stack.String(), "ExampleRecover"))
+
+	// Output:
+	// <nil>
+	// can't handle request
+	// └─ index out of range
+	// can't handle request
+	// └─ nil map
+	// true
+}
@@ -0,0 +1,68 @@
+package hierr
+
+import (
+	"sync"
+)
+
+// ReportCreated set whether every error, created by Errorf() and other
+// constructors of the package, including errors, which wrap other errors,
+// should be passed to registered reporters, as errors, passed to Report(),
+// are.
+var ReportCreated = false
+
+// Reporter handles reported errors, like metrics hook, which counts errors.
+type Reporter func(err error)
+
+var reporters struct {
+	sync.RWMutex
+
+	list []Reporter
+}
+
+// RegisterReporter registers reporter, which will be called with every
+// error, passed to Report(), and with every created error, if ReportCreated
+// is set. Reporters are called in order of their registration.
+func RegisterReporter(reporter Reporter) {
+	reporters.Lock()
+	defer reporters.Unlock()
+
+	reporters.list = append(reporters.list, reporter)
+}
+
+// Report passes error to registered reporters and records it in journal,
+// set by SetJournal(), and returns error as is, so it can be used inline:
+//
+//	return hierr.Report(hierr.Errorf(err, "can't deploy"))
+//
+// Nil errors are not reported.
+func Report(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if journal := defaultJournal.Load(); journal != nil {
+		journal.Record(err)
+	}
+
+	report(err)
+
+	return err
+}
+
+func report(err error) {
+	reporters.RLock()
+	list := reporters.list
+	reporters.RUnlock()
+
+	for _, reporter := range list {
+		reporter(err)
+	}
+}
+
+// resetReporters removes all registered reporters.
+func resetReporters() {
+	reporters.Lock()
+	defer reporters.Unlock()
+
+	reporters.list = nil
+}
@@ -0,0 +1,30 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleReport() {
+	defer resetReporters()
+
+	RegisterReporter(func(err error) {
+		fmt.Println("reported:", err.(Error).Message)
+	})
+
+	err := Report(Errorf(errors.New("disk is full"), "can't write"))
+	fmt.Println(err)
+
+	ReportCreated = true
+	defer func() {
+		ReportCreated = false
+	}()
+
+	_ = Errorf(nil, "can't connect")
+
+	// Output:
+	// reported: can't write
+	// can't write
+	// └─ disk is full
+	// reported: can't connect
+}
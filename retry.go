@@ -0,0 +1,113 @@
+package hierr
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DurationKey is a key of context pair, which contains duration of
+// operation, like duration of attempt, which is recorded by Retry().
+const DurationKey = "duration"
+
+// RetryPolicy represents schedule of attempts, which are made by Retry().
+type RetryPolicy struct {
+	// Attempts is a maximum number of attempts, function is called once if
+	// it's less than one.
+	Attempts int
+
+	// Delay is a delay before second attempt.
+	Delay time.Duration
+
+	// Backoff is a multiplier of delay before every next attempt, delay is
+	// constant if it's not greater than one.
+	Backoff float64
+
+	// MaxDelay limits delay between attempts, if it's set.
+	MaxDelay time.Duration
+
+	// RetryableOnly stops retrying after attempt, which error is not
+	// retryable according to IsRetryable().
+	RetryableOnly bool
+}
+
+// Retry calls given function until it succeeds, attempts of policy are
+// exhausted or context is done. If all attempts fail, hierarchy error is
+// returned, which reasons are "attempt 1", "attempt 2" and so on, each with
+// error of attempt and its duration as context pair with DurationKey key,
+// so history of failures is not lost:
+//
+//	all 2 attempts failed
+//	├─ attempt 1
+//	│  ├─ connection refused
+//	│  │
+//	│  └─ duration
+//	│     └─ 1.2s
+//	│
+//	└─ attempt 2
+//	   ├─ connection reset by peer
+//	   │
+//	   └─ duration
+//	      └─ 0.8s
+//
+// If context is done before all attempts are made, error of context is
+// added as the last reason. If RetryableOnly is set, retrying is stopped
+// after the first error, which is not retryable.
+func Retry(
+	ctx context.Context,
+	policy RetryPolicy,
+	fn func(ctx context.Context) error,
+) error {
+	attempts := []NestedError{}
+	delay := policy.Delay
+
+	for attempt := 1; ; attempt++ {
+		started := time.Now()
+
+		err := fn(ctx)
+		if err == nil {
+			return nil
+		}
+
+		attempts = append(attempts, Push(
+			fmt.Sprintf("attempt %d", attempt),
+			err,
+			Context(DurationKey, time.Since(started)),
+		))
+
+		if attempt >= policy.Attempts {
+			break
+		}
+
+		if policy.RetryableOnly && !IsRetryable(err) {
+			return Push(
+				fmt.Sprintf("%d of %d attempts failed", attempt, policy.Attempts),
+				attempts...,
+			)
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return Push(
+				fmt.Sprintf("%d of %d attempts failed", attempt, policy.Attempts),
+				append(attempts, ctx.Err())...,
+			)
+
+		case <-timer.C:
+		}
+
+		if policy.Backoff > 1 {
+			delay = time.Duration(float64(delay) * policy.Backoff)
+		}
+
+		if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+			delay = policy.MaxDelay
+		}
+	}
+
+	return Push(fmt.Sprintf("all %d attempts failed", len(attempts)), attempts...)
+}
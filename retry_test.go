@@ -0,0 +1,63 @@
+package hierr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+func ExampleRetry() {
+	policy := RetryPolicy{Attempts: 3, Delay: time.Millisecond, Backoff: 2}
+
+	calls := 0
+	err := Retry(context.Background(), policy, func(context.Context) error {
+		calls++
+		if calls < 2 {
+			return errors.New("connection refused")
+		}
+
+		return nil
+	})
+
+	fmt.Println(err, calls)
+
+	err = Retry(context.Background(), policy, func(context.Context) error {
+		return errors.New("connection refused")
+	})
+
+	fmt.Println(EqualIgnoringContext(err, Push(
+		"all 3 attempts failed",
+		Push("attempt 1", errors.New("connection refused")),
+		Push("attempt 2", errors.New("connection refused")),
+		Push("attempt 3", errors.New("connection refused")),
+	)))
+
+	durations := 0
+	for _, field := range AllFields(err) {
+		if _, ok := field.Value.(time.Duration); ok && field.Key == DurationKey {
+			durations++
+		}
+	}
+
+	fmt.Println(durations)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = Retry(ctx, policy, func(context.Context) error {
+		return errors.New("connection refused")
+	})
+
+	fmt.Println(EqualIgnoringContext(err, Push(
+		"1 of 3 attempts failed",
+		Push("attempt 1", errors.New("connection refused")),
+		context.Canceled,
+	)))
+
+	// Output:
+	// <nil> 2
+	// true
+	// 3
+	// true
+}
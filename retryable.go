@@ -0,0 +1,92 @@
+package hierr
+
+import (
+	"errors"
+	"net"
+	"syscall"
+)
+
+// RetryableKey is a key of context pair, which explicitly marks error as
+// retryable or not, it's added by WithRetryable().
+const RetryableKey = "retryable"
+
+// TransientErrors are errors, which are considered as transient by
+// IsRetryable(), if they're found by errors.Is() in terminal reasons of the
+// tree.
+var TransientErrors = []error{
+	syscall.ECONNREFUSED,
+	syscall.ECONNRESET,
+	syscall.ECONNABORTED,
+	syscall.EPIPE,
+	syscall.ETIMEDOUT,
+	syscall.EAGAIN,
+}
+
+// IsRetryable returns true if operation, which failed with given error, can
+// be retried, so retry loops share one classification of errors.
+//
+// If tree contains context pair with RetryableKey key and bool value, then
+// the pair, which is the closest to the root, decides, so callers can
+// override classification of errors they wrap; among pairs at the same
+// depth the first one decides. Otherwise error is retryable
+// if any of its terminal reasons is transient: it's a net.Error with
+// timeout, has Temporary() method, which returns true, or matches one of
+// TransientErrors. False is returned for nil error.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if retryable, ok := retryableFlag(err); ok {
+		return retryable
+	}
+
+	for _, leaf := range leaves(err) {
+		if leaf, ok := leaf.(error); ok && isTransient(leaf) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// retryableFlag returns value of context pair with RetryableKey key and bool
+// value, which is the closest to the root of given tree, searching the tree
+// breadth-first.
+func retryableFlag(err error) (bool, bool) {
+	queue := []NestedError{err}
+	for len(queue) > 0 {
+		_, fields, reasons := Decompose(queue[0])
+		queue = queue[1:]
+
+		for _, field := range fields {
+			if retryable, ok := field.Value.(bool); ok && field.Key == RetryableKey {
+				return retryable, true
+			}
+		}
+
+		queue = append(queue, reasons...)
+	}
+
+	return false, false
+}
+
+func isTransient(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	var temporary interface{ Temporary() bool }
+	if errors.As(err, &temporary) && temporary.Temporary() {
+		return true
+	}
+
+	for _, transient := range TransientErrors {
+		if errors.Is(err, transient) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,69 @@
+package hierr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"syscall"
+	"time"
+)
+
+func ExampleIsRetryable() {
+	refused := &net.OpError{
+		Op:  "dial",
+		Net: "tcp",
+		Err: os.NewSyscallError("connect", syscall.ECONNREFUSED),
+	}
+
+	fmt.Println(IsRetryable(Errorf(refused, "can't connect")))
+	fmt.Println(IsRetryable(Errorf(os.ErrDeadlineExceeded, "can't read")))
+	fmt.Println(IsRetryable(errors.New("invalid argument")))
+
+	fmt.Println(IsRetryable(NewWith(
+		"can't connect",
+		WithReason(refused),
+		WithRetryable(false),
+	)))
+
+	fmt.Println(IsRetryable(Build("quota is exceeded").Retryable(true).Err()))
+
+	fmt.Println(IsRetryable(Push(
+		"can't sync",
+		Push("can't read", Push("can't decode", Context(RetryableKey, false))),
+		Push("can't write", Context(RetryableKey, true)),
+	)))
+
+	// Output:
+	// true
+	// true
+	// false
+	// false
+	// true
+	// true
+}
+
+func ExampleRetryPolicy_retryableOnly() {
+	attempts := 0
+
+	err := Retry(
+		context.Background(),
+		RetryPolicy{Attempts: 3, Delay: time.Millisecond, RetryableOnly: true},
+		func(context.Context) error {
+			attempts++
+
+			return errors.New("invalid argument")
+		},
+	)
+
+	fmt.Println(attempts)
+	fmt.Println(EqualIgnoringContext(err, Push(
+		"1 of 3 attempts failed",
+		Push("attempt 1", errors.New("invalid argument")),
+	)))
+
+	// Output:
+	// 1
+	// true
+}
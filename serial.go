@@ -0,0 +1,83 @@
+package hierr
+
+// SerialNode represents node of error tree in serializable form, which is
+// shared by codecs, like hierrmsgpack, hierrcbor, hierrjsonrpc and
+// hierrproto, so they encode trees in the same way. Context pairs and
+// nested reasons are kept in single list, so their order is preserved.
+// Nodes are encoded as arrays by MessagePack and CBOR codecs.
+type SerialNode struct {
+	_ struct{} `msgpack:",as_array" cbor:",toarray"`
+
+	Message  string        `json:"message"`
+	Children []SerialChild `json:"children,omitempty"`
+}
+
+// SerialChild represents either nested reason, if Reason is set, or context
+// pair, which value is converted to string.
+type SerialChild struct {
+	_ struct{} `msgpack:",as_array" cbor:",toarray"`
+
+	Key    string      `json:"key,omitempty"`
+	Value  string      `json:"value,omitempty"`
+	Reason *SerialNode `json:"reason,omitempty"`
+}
+
+// ToSerial converts error tree into serializable form. Values of context
+// pairs are converted to strings, metadata of nodes, like IDs or hints, is
+// not kept, use MarshalBinary() to encode it as well.
+func ToSerial(node NestedError) SerialNode {
+	node = dereference(node)
+
+	if decomposed, ok := decompose(node); ok {
+		node = decomposed
+	}
+
+	hierarchical, ok := node.(HierarchicalError)
+	if !ok {
+		return SerialNode{Message: String(node)}
+	}
+
+	serial := SerialNode{Message: hierarchical.GetMessage()}
+
+	for _, nested := range hierarchical.GetNested() {
+		if field, ok := getField(nested); ok {
+			serial.Children = append(serial.Children, SerialChild{
+				Key:   field.Key,
+				Value: String(field.Value),
+			})
+
+			continue
+		}
+
+		reason := ToSerial(nested)
+
+		serial.Children = append(serial.Children, SerialChild{
+			Reason: &reason,
+		})
+	}
+
+	return serial
+}
+
+// FromSerial converts serialized node into hierarchy error. Nested reasons
+// are converted into hierr.Error too.
+func FromSerial(node SerialNode) Error {
+	err := Error{Message: node.Message}
+
+	if len(node.Children) == 0 {
+		return err
+	}
+
+	nested := []NestedError{}
+	for _, child := range node.Children {
+		if child.Reason != nil {
+			nested = append(nested, FromSerial(*child.Reason))
+		} else {
+			nested = append(nested, Context(child.Key, child.Value))
+		}
+	}
+
+	err.Nested = nested
+
+	return err
+}
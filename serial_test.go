@@ -0,0 +1,28 @@
+package hierr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+func ExampleToSerial() {
+	err := Push(
+		"can't create job",
+		Context("job", 42),
+		Errorf(errors.New("disk is full"), "can't write spool"),
+		Context("queue", "default"),
+	)
+
+	encoded, _ := json.Marshal(ToSerial(err))
+	fmt.Println(string(encoded))
+
+	decoded := SerialNode{}
+	_ = json.Unmarshal(encoded, &decoded)
+
+	fmt.Println(FromSerial(decoded).Error() == err.Error())
+
+	// Output:
+	// {"message":"can't create job","children":[{"key":"job","value":"42"},{"reason":{"message":"can't write spool","children":[{"reason":{"message":"disk is full"}}]}},{"key":"queue","value":"default"}]}
+	// true
+}
@@ -0,0 +1,85 @@
+package hierr
+
+import (
+	"fmt"
+)
+
+// Severity represents severity level of error node, so branches of
+// aggregated tree can be marked as warnings instead of failures.
+type Severity int
+
+// Severity levels in ascending order. Zero severity means that severity is
+// not set and SeverityError is assumed.
+const (
+	SeverityDebug Severity = iota + 1
+	SeverityInfo
+	SeverityWarn
+	SeverityError
+	SeverityFatal
+)
+
+// Colorize set whether messages of errors with severity should be colored
+// according to their severity when error is displayed, using ANSI escape
+// sequences.
+var Colorize = false
+
+var severityColors = map[Severity]string{
+	SeverityDebug: "\x1b[2m",
+	SeverityInfo:  "\x1b[36m",
+	SeverityWarn:  "\x1b[33m",
+	SeverityError: "\x1b[31m",
+	SeverityFatal: "\x1b[1;31m",
+}
+
+// String returns name of severity level.
+func (severity Severity) String() string {
+	switch severity {
+	case SeverityDebug:
+		return "debug"
+	case SeverityInfo:
+		return "info"
+	case SeverityWarn:
+		return "warn"
+	case SeverityError:
+		return "error"
+	case SeverityFatal:
+		return "fatal"
+	}
+
+	return fmt.Sprintf("Severity(%d)", int(severity))
+}
+
+// WithSeverity returns copy of the error with given severity.
+func (err Error) WithSeverity(severity Severity) Error {
+	err.Severity = severity
+
+	return err
+}
+
+// GetSeverity returns severity of the error, SeverityError is returned if
+// severity is not set.
+func (err Error) GetSeverity() Severity {
+	return GetSeverity(err)
+}
+
+// GetSeverity returns severity of given node, which is set on hierr error.
+// SeverityError is returned for nodes, which are not hierr errors or have no
+// severity, so log adapters can map it to their native levels.
+func GetSeverity(node NestedError) Severity {
+	if err, ok := dereference(node).(Error); ok && err.Severity != 0 {
+		return err.Severity
+	}
+
+	return SeverityError
+}
+
+// colorize returns message, colored according to given severity, message is
+// returned as is if severity is not set.
+func colorize(message string, severity Severity) string {
+	color, ok := severityColors[severity]
+	if !ok {
+		return message
+	}
+
+	return color + message + "\x1b[0m"
+}
@@ -0,0 +1,41 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleError_WithSeverity() {
+	warning := Errorf(errors.New("disk is 90% full"), "low disk space").(Error).
+		WithSeverity(SeverityWarn)
+
+	err := Push("can't deploy", warning, errors.New("connection refused")).(Error)
+
+	fmt.Println(err.GetSeverity())
+	fmt.Println(warning.GetSeverity())
+
+	Colorize = true
+	defer func() {
+		Colorize = false
+	}()
+
+	fmt.Printf("%q\n", warning.Error())
+
+	// Output:
+	// error
+	// warn
+	// "\x1b[33mlow disk space\x1b[0m\n└─ disk is 90% full"
+}
+
+func ExampleWithSeverity() {
+	err := NewWith("cache is cold", WithSeverity(SeverityInfo))
+
+	fmt.Println(GetSeverity(err))
+	fmt.Println(GetSeverity(Build("can't warm cache").Severity(SeverityDebug).Err()))
+	fmt.Println(GetSeverity(errors.New("connection refused")))
+
+	// Output:
+	// info
+	// debug
+	// error
+}
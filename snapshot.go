@@ -0,0 +1,61 @@
+package hierr
+
+import (
+	"sort"
+)
+
+// Snapshot returns deterministic representation of the error, which is
+// intended for golden-file tests. Error is rendered using BranchDelimiterBox,
+// BranchChainerBox, BranchSplitterBox and indentation of 3 spaces, context
+// pairs of every node are sorted by their keys, and caller locations, call
+// stacks and duplicate counters are not rendered, so result doesn't depend on
+// package variables, which are set elsewhere in the binary.
+func Snapshot(err error) string {
+	if err == nil {
+		return "<nil>"
+	}
+
+	options := rendering{
+		delimiter: BranchDelimiterBox,
+		chainer:   BranchChainerBox,
+		splitter:  BranchSplitterBox,
+		indent:    3,
+		sorted:    true,
+	}
+
+	return render(err, options)
+}
+
+// sortContext returns copy of given children, where context pairs are sorted
+// by their keys, while reasons are kept in their places.
+func sortContext(children []NestedError) []NestedError {
+	var (
+		positions = []int{}
+		pairs     = []NestedError{}
+	)
+
+	for index, child := range children {
+		if _, ok := getField(child); ok {
+			positions = append(positions, index)
+			pairs = append(pairs, child)
+		}
+	}
+
+	if len(pairs) < 2 {
+		return children
+	}
+
+	sort.SliceStable(pairs, func(i, j int) bool {
+		left, _ := getField(pairs[i])
+		right, _ := getField(pairs[j])
+
+		return left.Key < right.Key
+	})
+
+	sorted := append([]NestedError{}, children...)
+	for index, position := range positions {
+		sorted[position] = pairs[index]
+	}
+
+	return sorted
+}
@@ -0,0 +1,40 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleSnapshot() {
+	defer func() {
+		BranchDelimiter = BranchDelimiterBox
+		BranchSplitter = BranchSplitterBox
+		BranchIndent = 3
+		RecordCaller = false
+	}()
+
+	BranchDelimiter = BranchDelimiterASCII
+	BranchSplitter = BranchSplitterASCII
+	BranchIndent = 6
+	RecordCaller = true
+
+	err := Context(
+		Errorf(errors.New("connection refused"), "can't connect"),
+		Context("port", 22),
+		Context("host", "example.com"),
+	)
+
+	fmt.Println(Snapshot(err))
+	fmt.Println(Snapshot(nil))
+
+	// Output:
+	// can't connect
+	// ├─ connection refused
+	// │
+	// ├─ host
+	// │  └─ example.com
+	// │
+	// └─ port
+	//    └─ 22
+	// <nil>
+}
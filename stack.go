@@ -0,0 +1,189 @@
+package hierr
+
+import (
+	"fmt"
+	"runtime"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// StackDepth set maximum number of frames, which will be captured by
+// ErrorfStack() and ErrorfStackSkip().
+var StackDepth = 32
+
+// Stack represents call stack, which is captured when error is created.
+type Stack struct {
+	pcs []uintptr
+}
+
+// ErrorfStack creates new hierarchy error as Errorf() does and captures
+// call stack of the caller. Captured stack is not displayed by Error(), but
+// displayed when error is printed using %+v verb.
+func ErrorfStack(
+	nestedError NestedError,
+	message string,
+	args ...interface{},
+) error {
+	return newError(1, true, nestedError, fmt.Sprintf(message, args...))
+}
+
+// ErrorfStackSkip creates new hierarchy error as ErrorfStack() does, but
+// skips specified number of additional frames when capturing call stack, so
+// frames of helper functions, which wrap ErrorfStackSkip(), are not
+// captured.
+func ErrorfStackSkip(
+	skip int,
+	nestedError NestedError,
+	message string,
+	args ...interface{},
+) error {
+	return newError(
+		skip+1, true, nestedError, fmt.Sprintf(message, args...),
+	)
+}
+
+// Frames returns frames of captured call stack, starting from the caller,
+// which created error.
+func (stack *Stack) Frames() []runtime.Frame {
+	if stack == nil || len(stack.pcs) == 0 {
+		return nil
+	}
+
+	frames := []runtime.Frame{}
+
+	iterator := runtime.CallersFrames(stack.pcs)
+	for {
+		frame, more := iterator.Next()
+
+		frames = append(frames, frame)
+
+		if !more {
+			break
+		}
+	}
+
+	return frames
+}
+
+// PCs returns program counters of captured call stack.
+func (stack *Stack) PCs() []uintptr {
+	if stack == nil {
+		return nil
+	}
+
+	return append([]uintptr{}, stack.pcs...)
+}
+
+// String returns captured call stack, one frame per line.
+func (stack *Stack) String() string {
+	text := ""
+	for index, frame := range stack.Frames() {
+		if index > 0 {
+			text += "\n"
+		}
+
+		text += fmt.Sprintf("%s (%s:%d)", frame.Function, frame.File, frame.Line)
+	}
+
+	return text
+}
+
+// GetStack returns call stack, which is captured by the deepest node of
+// given error tree, so it's the closest one to the root cause, nil is
+// returned if no node has captured stack. If several nodes at the same
+// depth have stacks, the first one is returned.
+func GetStack(node NestedError) *Stack {
+	stack, _ := deepestStack(node, 0)
+
+	return stack
+}
+
+func deepestStack(node NestedError, depth int) (*Stack, int) {
+	var (
+		stack   *Stack
+		deepest int
+	)
+
+	if err, ok := dereference(node).(Error); ok && err.Stack != nil {
+		stack, deepest = err.Stack, depth
+	}
+
+	_, _, reasons := Decompose(node)
+	for _, reason := range reasons {
+		nested, level := deepestStack(reason, depth+1)
+		if nested != nil && (stack == nil || level > deepest) {
+			stack, deepest = nested, level
+		}
+	}
+
+	return stack, deepest
+}
+
+// captureStack captures call stack, skipping specified number of frames
+// beside caller of captureStack.
+func captureStack(skip int) *Stack {
+	depth := GetConfig().StackDepth
+	if depth <= 0 {
+		return &Stack{}
+	}
+
+	pcs := make([]uintptr, depth)
+
+	return &Stack{
+		pcs: pcs[:runtime.Callers(skip+2, pcs)],
+	}
+}
+
+// formatStack formats frames of given stack, which are not shared with the
+// stack of parent error. Frames, which are shared with parent, are collapsed
+// into "... N more" line.
+func formatStack(stack *Stack, parent *Stack) string {
+	common := 0
+	if parent != nil {
+		for common < len(stack.pcs) && common < len(parent.pcs) &&
+			stack.pcs[len(stack.pcs)-1-common] ==
+				parent.pcs[len(parent.pcs)-1-common] {
+			common++
+		}
+	}
+
+	unique := &Stack{pcs: stack.pcs[:len(stack.pcs)-common]}
+
+	text := ""
+	for _, frame := range unique.Frames() {
+		text += fmt.Sprintf(
+			"\n  at %s (%s:%d)", frame.Function, frame.File, frame.Line,
+		)
+	}
+
+	if common > 0 {
+		text += fmt.Sprintf("\n  ... %d more", common)
+	}
+
+	return text
+}
+
+// Frame represents program counter of stack frame. It's errors.Frame of
+// github.com/pkg/errors, so frames are formatted in the same way.
+type Frame = pkgerrors.Frame
+
+// StackTrace represents stack of frames, starting from the innermost one.
+// It's errors.StackTrace of github.com/pkg/errors, so hierr errors satisfy
+// interface{ StackTrace() errors.StackTrace }, which is used by pkg/errors,
+// Sentry and Bugsnag to extract stack traces.
+type StackTrace = pkgerrors.StackTrace
+
+// StackTrace returns captured call stack in pkg/errors compatible form, nil
+// is returned if no stack was captured.
+func (err Error) StackTrace() StackTrace {
+	if err.Stack == nil {
+		return nil
+	}
+
+	trace := StackTrace{}
+	for _, pc := range err.Stack.pcs {
+		trace = append(trace, Frame(pc))
+	}
+
+	return trace
+}
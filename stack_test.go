@@ -0,0 +1,129 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+func pullRemote() error {
+	return ErrorfStack(errors.New("exit status 128"), "can't pull remote")
+}
+
+func ExampleErrorfStack() {
+	err := Errorf(pullRemote(), "can't update repository")
+
+	// file paths and runtime frames depend on environment
+	var (
+		runtimeFrames = regexp.MustCompile(`\n\s+at (testing|main|runtime)\..*`)
+		filePaths     = regexp.MustCompile(`\(.*/([^/]+):\d+\)`)
+	)
+
+	verbose := fmt.Sprintf("%+v", err)
+	verbose = runtimeFrames.ReplaceAllString(verbose, "")
+	verbose = filePaths.ReplaceAllString(verbose, "($1)")
+
+	fmt.Println(err)
+	fmt.Println()
+	fmt.Println(verbose)
+
+	// Output:
+	// can't update repository
+	// └─ can't pull remote
+	//    └─ exit status 128
+	//
+	// can't update repository
+	// └─ can't pull remote
+	//      at github.com/reconquest/hierr-go.pullRemote (stack_test.go)
+	//      at github.com/reconquest/hierr-go.ExampleErrorfStack (stack_test.go)
+	//    └─ exit status 128
+}
+
+func ExampleStack_Frames() {
+	err := pullRemote().(Error)
+
+	fmt.Println(err.Stack.Frames()[0].Function)
+
+	// Output:
+	// github.com/reconquest/hierr-go.pullRemote
+}
+
+func ExampleError_StackTrace() {
+	trace := pullRemote().(Error).StackTrace()
+
+	fmt.Printf("%s:%n\n", trace[0], trace[0])
+	fmt.Printf("%n\n", trace[1])
+	fmt.Println(Errorf(nil, "no stack").(Error).StackTrace() == nil)
+
+	_, ok := pullRemote().(interface{ StackTrace() pkgerrors.StackTrace })
+	fmt.Println(ok)
+
+	// Output:
+	// stack_test.go:pullRemote
+	// ExampleError_StackTrace
+	// true
+	// true
+}
+
+func failf(message string) error {
+	return ErrorfStackSkip(1, nil, "%s", message)
+}
+
+func ExampleErrorfStackSkip() {
+	defer func() {
+		StackDepth = 32
+	}()
+
+	StackDepth = 1
+
+	frames := failf("helper").(Error).Stack.Frames()
+
+	fmt.Println(len(frames))
+	fmt.Println(frames[0].Function)
+
+	// Output:
+	// 1
+	// github.com/reconquest/hierr-go.ExampleErrorfStackSkip
+}
+
+func updateRepository() error {
+	return ErrorfStack(pullRemote(), "can't update repository")
+}
+
+func ExampleErrorfStack_deduplication() {
+	var (
+		runtimeFrames = regexp.MustCompile(`\n\s+at (testing|main|runtime)\..*`)
+		filePaths     = regexp.MustCompile(`\(.*/([^/]+):\d+\)`)
+		more          = regexp.MustCompile(`\.\.\. \d+ more`)
+	)
+
+	verbose := fmt.Sprintf("%+v", updateRepository())
+	verbose = runtimeFrames.ReplaceAllString(verbose, "")
+	verbose = filePaths.ReplaceAllString(verbose, "($1)")
+	verbose = more.ReplaceAllString(verbose, "... N more")
+
+	fmt.Println(verbose)
+
+	// Output:
+	// can't update repository
+	//   at github.com/reconquest/hierr-go.updateRepository (stack_test.go)
+	//   at github.com/reconquest/hierr-go.ExampleErrorfStack_deduplication (stack_test.go)
+	// └─ can't pull remote
+	//      at github.com/reconquest/hierr-go.pullRemote (stack_test.go)
+	//      at github.com/reconquest/hierr-go.updateRepository (stack_test.go)
+	//      ... N more
+	//    └─ exit status 128
+}
+
+func ExampleGetStack() {
+	err := ErrorfStack(Errorf(pullRemote(), "can't update"), "can't deploy")
+
+	fmt.Println(GetStack(err).Frames()[0].Function)
+	fmt.Println(GetStack(errors.New("timeout")) == nil)
+
+	// Output:
+	// github.com/reconquest/hierr-go.pullRemote
+	// true
+}
@@ -0,0 +1,68 @@
+package hierr
+
+// WithTags returns copy of the error with given tags added, so alert
+// routing and ownership assignment can be driven by labels instead of
+// matching messages:
+//
+//	err = hierr.Errorf(err, "can't connect").(hierr.Error).
+//		WithTags("network", "tenant:acme")
+//
+// Tags are not rendered. Tags, which are already attached, are not added
+// twice.
+func (err Error) WithTags(tags ...string) Error {
+	merged := err.GetTags()
+	for _, tag := range tags {
+		if !containsTag(merged, tag) {
+			merged = append(merged, tag)
+		}
+	}
+
+	err.tags = &merged
+
+	return err
+}
+
+// GetTags returns tags, which are attached to the error.
+func (err Error) GetTags() []string {
+	if err.tags == nil {
+		return nil
+	}
+
+	return append([]string{}, *err.tags...)
+}
+
+// HasTag returns true if any node of given error tree has given tag.
+func HasTag(err error, tag string) bool {
+	return containsTag(AllTags(err), tag)
+}
+
+// AllTags returns unique tags of given error and all its nested reasons, in
+// order of descending into the tree.
+func AllTags(node NestedError) []string {
+	tags := []string{}
+
+	if err, ok := dereference(node).(Error); ok {
+		tags = append(tags, err.GetTags()...)
+	}
+
+	_, _, reasons := Decompose(node)
+	for _, reason := range reasons {
+		for _, tag := range AllTags(reason) {
+			if !containsTag(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+	}
+
+	return tags
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, candidate := range tags {
+		if candidate == tag {
+			return true
+		}
+	}
+
+	return false
+}
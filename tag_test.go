@@ -0,0 +1,30 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleHasTag() {
+	err := Push(
+		"can't sync tenant",
+		Errorf(errors.New("connection refused"), "can't connect").(Error).
+			WithTags("network", "retryable"),
+		NewWith("quota is exceeded", WithTags("tenant:acme")),
+	)
+
+	fmt.Println(HasTag(err, "network"))
+	fmt.Println(HasTag(err, "tenant:acme"))
+	fmt.Println(HasTag(err, "storage"))
+	fmt.Println(AllTags(err))
+
+	tagged := Build("can't write").Tags("storage", "storage").Err().(Error)
+	fmt.Println(tagged.GetTags())
+
+	// Output:
+	// true
+	// true
+	// false
+	// [network retryable tenant:acme]
+	// [storage]
+}
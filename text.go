@@ -0,0 +1,60 @@
+package hierr
+
+import (
+	"strings"
+)
+
+// FlatText set whether MarshalText() should return flat form of error, which
+// is returned by Flat(), instead of rendered tree, so encoders, which write
+// text values on single line, like logfmt or CSV writers, get readable
+// output.
+var FlatText = false
+
+// MarshalText implements encoding.TextMarshaler interface, so configuration
+// and report writers and encoders, which honor it, get rendered tree of
+// error, as Error() returns, or its flat form, if FlatText is set.
+func (err Error) MarshalText() ([]byte, error) {
+	if GetConfig().FlatText {
+		return []byte(Flat(err)), nil
+	}
+
+	return []byte(err.Error()), nil
+}
+
+// Flat returns representation of error on single line, where reasons are
+// chained using ": ", as errors, wrapped using fmt.Errorf("...: %w"), are,
+// several reasons are enclosed into brackets and separated by "; ", and
+// context pairs are appended to message in parentheses:
+//
+//	can't deploy (host=example.com): [can't connect: connection refused; disk is full]
+func Flat(err error) string {
+	return flat(err)
+}
+
+func flat(node NestedError) string {
+	message, fields, reasons := Decompose(node)
+
+	if len(fields) > 0 {
+		pairs := []string{}
+		for _, field := range fields {
+			pairs = append(pairs, field.Key+"="+String(field.Value))
+		}
+
+		message += " (" + strings.Join(pairs, ", ") + ")"
+	}
+
+	switch len(reasons) {
+	case 0:
+		return message
+
+	case 1:
+		return message + ": " + flat(reasons[0])
+	}
+
+	chained := []string{}
+	for _, reason := range reasons {
+		chained = append(chained, flat(reason))
+	}
+
+	return message + ": [" + strings.Join(chained, "; ") + "]"
+}
@@ -0,0 +1,53 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleError_MarshalText() {
+	err := Push(
+		"can't deploy",
+		Errorf(errors.New("connection refused"), "can't connect"),
+		errors.New("disk is full"),
+		Context("host", "example.com"),
+	)
+
+	text, _ := err.(Error).MarshalText()
+	fmt.Println(string(text))
+
+	FlatText = true
+	defer func() {
+		FlatText = false
+	}()
+
+	text, _ = err.(Error).MarshalText()
+	fmt.Println(string(text))
+
+	// Output:
+	// can't deploy
+	// ├─ can't connect
+	// │  └─ connection refused
+	// │
+	// ├─ disk is full
+	// │
+	// └─ host
+	//    └─ example.com
+	// can't deploy (host=example.com): [can't connect: connection refused; disk is full]
+}
+
+func ExampleFlat() {
+	err := Errorf(
+		Errorf(errors.New("exit status 128"), "can't run git fetch"),
+		"can't pull remote %q", "origin",
+	)
+
+	fmt.Println(Flat(err))
+	fmt.Println(ParseChain(Flat(err)))
+
+	// Output:
+	// can't pull remote "origin": can't run git fetch: exit status 128
+	// can't pull remote "origin"
+	// └─ can't run git fetch
+	//    └─ exit status 128
+}
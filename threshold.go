@@ -0,0 +1,22 @@
+package hierr
+
+// RenderAtLeast renders error as Error() does, but shows only nodes, which
+// severity is at or above given threshold, so verbose diagnostic tree can be
+// shown tersely to users and fully to operators:
+//
+//	fmt.Println(hierr.RenderAtLeast(err, hierr.SeverityWarn))
+//
+// Nodes without severity are considered as SeverityError. Nested reasons of
+// hidden nodes are hidden as well, context pairs are kept. Empty string is
+// returned for nil error and error, which severity is below threshold.
+func RenderAtLeast(err error, threshold Severity) string {
+	if err == nil || GetSeverity(err) < threshold {
+		return ""
+	}
+
+	tree := asError(dereference(err)).Prune(func(node Error) bool {
+		return GetSeverity(node) < threshold
+	})
+
+	return tree.format(newRendering(IsDebug()))
+}
@@ -0,0 +1,38 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleRenderAtLeast() {
+	err := Push(
+		"deployment finished with problems",
+		Errorf(errors.New("disk is 90% full"), "low disk space").(Error).
+			WithSeverity(SeverityWarn),
+		Errorf(nil, "cache is cold").(Error).WithSeverity(SeverityInfo),
+		errors.New("can't restart worker"),
+	)
+
+	fmt.Println(RenderAtLeast(err, SeverityDebug))
+	fmt.Println(RenderAtLeast(err, SeverityWarn))
+	fmt.Println(RenderAtLeast(err, SeverityError))
+	fmt.Printf("%q\n", RenderAtLeast(err, SeverityFatal))
+
+	// Output:
+	// deployment finished with problems
+	// ├─ low disk space
+	// │  └─ disk is 90% full
+	// │
+	// ├─ cache is cold
+	// │
+	// └─ can't restart worker
+	// deployment finished with problems
+	// ├─ low disk space
+	// │  └─ disk is 90% full
+	// │
+	// └─ can't restart worker
+	// deployment finished with problems
+	// └─ can't restart worker
+	// ""
+}
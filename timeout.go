@@ -0,0 +1,41 @@
+package hierr
+
+import (
+	"errors"
+)
+
+// Timeout returns true if any nested reason of the error, which implements
+// Timeout() method, reports timeout, so code, which checks net.Error
+// semantics, still works after error is wrapped.
+func (err Error) Timeout() bool {
+	return anyReason(err, func(reason error) bool {
+		var timeout interface{ Timeout() bool }
+
+		return errors.As(reason, &timeout) && timeout.Timeout()
+	})
+}
+
+// Temporary returns true if any nested reason of the error, which
+// implements Temporary() method, reports temporary failure, as Timeout()
+// does for timeouts.
+func (err Error) Temporary() bool {
+	return anyReason(err, func(reason error) bool {
+		var temporary interface{ Temporary() bool }
+
+		return errors.As(reason, &temporary) && temporary.Temporary()
+	})
+}
+
+// anyReason returns true if predicate is true for any reason of given
+// error, which is error. Nested hierr errors are checked by their own
+// methods, so predicate descends into the whole tree.
+func anyReason(err Error, predicate func(error) bool) bool {
+	_, _, reasons := Decompose(err)
+	for _, reason := range reasons {
+		if reason, ok := dereference(reason).(error); ok && predicate(reason) {
+			return true
+		}
+	}
+
+	return false
+}
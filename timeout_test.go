@@ -0,0 +1,29 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+)
+
+func ExampleError_Timeout() {
+	err := Push(
+		"can't fetch",
+		errors.New("connection refused"),
+		Errorf(os.ErrDeadlineExceeded, "can't read response"),
+	)
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		fmt.Println(netErr.Timeout())
+	}
+
+	fmt.Println(Errorf(errors.New("connection refused"), "can't fetch").(Error).Timeout())
+	fmt.Println(err.(Error).Temporary())
+
+	// Output:
+	// true
+	// false
+	// true
+}
@@ -0,0 +1,50 @@
+package hierr
+
+import (
+	"time"
+)
+
+// RecordTime set whether Errorf() should record creation time of every
+// error, so verbose representation, which is returned for %+v verb, shows
+// offsets of nodes relative to the earliest node in the tree, and tree of
+// retries or phases doubles as timeline of the failure:
+//
+//	can't deploy [+1.52s]
+//	├─ attempt 1 [+0s]
+//	│  └─ connection refused
+//	│
+//	└─ attempt 2 [+1.2s]
+//	   └─ connection refused
+var RecordTime = false
+
+// GetTime returns creation time of the error, zero time is returned if it's
+// not recorded.
+func (err Error) GetTime() time.Time {
+	return err.Time
+}
+
+// earliestTime returns the earliest creation time of nodes of given tree,
+// zero time is returned if no time is recorded.
+func earliestTime(node NestedError) time.Time {
+	var earliest time.Time
+
+	if err, ok := dereference(node).(Error); ok {
+		earliest = err.Time
+	}
+
+	_, _, reasons := Decompose(node)
+	for _, reason := range reasons {
+		moment := earliestTime(reason)
+		if !moment.IsZero() && (earliest.IsZero() || moment.Before(earliest)) {
+			earliest = moment
+		}
+	}
+
+	return earliest
+}
+
+// formatOffset returns offset of given time relative to epoch, which is
+// rounded to milliseconds.
+func formatOffset(moment time.Time, epoch time.Time) string {
+	return " [+" + moment.Sub(epoch).Round(time.Millisecond).String() + "]"
+}
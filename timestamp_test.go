@@ -0,0 +1,61 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+func ExampleRecordTime() {
+	started := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	attempt := func(number int, offset time.Duration) Error {
+		return Error{
+			Message: fmt.Sprintf("attempt %d", number),
+			Nested:  errors.New("connection refused"),
+			Time:    started.Add(offset),
+		}
+	}
+
+	err := Error{
+		Message: "can't deploy",
+		Nested: []NestedError{
+			attempt(1, 0),
+			attempt(2, 1200*time.Millisecond),
+		},
+		Time: started.Add(1520 * time.Millisecond),
+	}
+
+	fmt.Printf("%+v\n", err)
+	fmt.Println(err)
+
+	// Output:
+	// can't deploy [+1.52s]
+	// ├─ attempt 1 [+0s]
+	// │  └─ connection refused
+	// │
+	// └─ attempt 2 [+1.2s]
+	//    └─ connection refused
+	// can't deploy
+	// ├─ attempt 1
+	// │  └─ connection refused
+	// │
+	// └─ attempt 2
+	//    └─ connection refused
+}
+
+func ExampleError_GetTime() {
+	RecordTime = true
+	defer func() {
+		RecordTime = false
+	}()
+
+	err := Errorf(nil, "can't connect").(Error)
+
+	fmt.Println(err.GetTime().IsZero())
+	fmt.Println(Errorf(nil, "can't connect").(Error).Clone().GetTime().IsZero())
+
+	// Output:
+	// false
+	// false
+}
@@ -0,0 +1,47 @@
+package hierr
+
+// ToMap converts error tree into nested generic structure, so it can be fed
+// into any encoder, like BSON, TOML or template engine:
+//
+//	map[string]interface{}{
+//		"message": "can't deploy",
+//		"context": map[string]interface{}{"host": "example.com"},
+//		"reasons": []interface{}{
+//			map[string]interface{}{"message": "connection refused"},
+//		},
+//	}
+//
+// Context and reasons are set only if node has them. Values of context
+// pairs are kept as is, if the same key is used several times in one node,
+// the last value is used.
+func (err Error) ToMap() map[string]interface{} {
+	return toMap(err)
+}
+
+func toMap(node NestedError) map[string]interface{} {
+	message, fields, reasons := Decompose(node)
+
+	result := map[string]interface{}{
+		"message": message,
+	}
+
+	if len(fields) > 0 {
+		context := map[string]interface{}{}
+		for _, field := range fields {
+			context[field.Key] = field.Value
+		}
+
+		result["context"] = context
+	}
+
+	if len(reasons) > 0 {
+		nested := []interface{}{}
+		for _, reason := range reasons {
+			nested = append(nested, toMap(reason))
+		}
+
+		result["reasons"] = nested
+	}
+
+	return result
+}
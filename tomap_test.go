@@ -0,0 +1,20 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleError_ToMap() {
+	err := Push(
+		"can't deploy",
+		errors.New("connection refused"),
+		Context("host", "example.com"),
+		Context("attempts", 3),
+	)
+
+	fmt.Println(err.(Error).ToMap())
+
+	// Output:
+	// map[context:map[attempts:3 host:example.com] message:can't deploy reasons:[map[message:connection refused]]]
+}
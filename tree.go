@@ -0,0 +1,87 @@
+package hierr
+
+import (
+	"errors"
+)
+
+// Leaves returns every terminal reason of the error tree, which has no
+// nested reasons, in order of descending into the tree. Context pairs are
+// not considered as reasons. If error has no reasons, then error itself is
+// returned.
+func (err Error) Leaves() []NestedError {
+	return leaves(err)
+}
+
+func leaves(node NestedError) []NestedError {
+	_, _, reasons := Decompose(node)
+	if len(reasons) == 0 {
+		return []NestedError{node}
+	}
+
+	result := []NestedError{}
+	for _, reason := range reasons {
+		result = append(result, leaves(reason)...)
+	}
+
+	return result
+}
+
+// Flatten returns error and all its nested reasons in order of descending
+// into the tree (pre-order), so hierarchy can be passed to APIs, which
+// understand only slices of errors. Reasons, which are not errors, like
+// strings, are converted to errors. Context pairs are not returned.
+func (err Error) Flatten() []error {
+	return flatten(err)
+}
+
+func flatten(node NestedError) []error {
+	err, ok := node.(error)
+	if !ok {
+		err = errors.New(String(node))
+	}
+
+	result := []error{err}
+
+	_, _, reasons := Decompose(node)
+	for _, reason := range reasons {
+		result = append(result, flatten(reason)...)
+	}
+
+	return result
+}
+
+// Depth returns maximum depth of the error tree. Error without reasons has
+// depth 1. Context pairs are not counted.
+func (err Error) Depth() int {
+	return depth(err)
+}
+
+// Count returns total number of nodes in the error tree, including error
+// itself. Context pairs are not counted.
+func (err Error) Count() int {
+	return count(err)
+}
+
+func depth(node NestedError) int {
+	_, _, reasons := Decompose(node)
+
+	deepest := 0
+	for _, reason := range reasons {
+		if reasonDepth := depth(reason); reasonDepth > deepest {
+			deepest = reasonDepth
+		}
+	}
+
+	return deepest + 1
+}
+
+func count(node NestedError) int {
+	_, _, reasons := Decompose(node)
+
+	total := 1
+	for _, reason := range reasons {
+		total += count(reason)
+	}
+
+	return total
+}
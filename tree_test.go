@@ -0,0 +1,77 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleError_Leaves() {
+	err := Push(
+		"can't deploy",
+		Context(
+			Errorf(errors.New("connection refused"), "can't connect"),
+			Context("host", "node-a"),
+		),
+		Errorf(
+			Push("can't upload", errors.New("disk is full"), "timeout"),
+			"can't sync",
+		),
+	).(Error)
+
+	for _, leaf := range err.Leaves() {
+		fmt.Println(String(leaf))
+	}
+
+	fmt.Println(String(Errorf(nil, "single").(Error).Leaves()[0]))
+
+	// Output:
+	// connection refused
+	// disk is full
+	// timeout
+	// single
+}
+
+func ExampleError_Flatten() {
+	refused := errors.New("connection refused")
+
+	err := Push(
+		"can't deploy",
+		Context(
+			Errorf(refused, "can't connect"),
+			Context("host", "node-a"),
+		),
+		"timeout",
+	).(Error)
+
+	errs := err.Flatten()
+	for _, err := range errs {
+		fmt.Printf("%T: %q\n", err, err.Error())
+	}
+
+	fmt.Println(errors.Is(errors.Join(errs...), refused))
+
+	// Output:
+	// hierr.Error: "can't deploy\n├─ can't connect\n│  ├─ connection refused\n│  │\n│  └─ host\n│     └─ node-a\n│\n└─ timeout"
+	// hierr.Error: "can't connect\n├─ connection refused\n│\n└─ host\n   └─ node-a"
+	// *errors.errorString: "connection refused"
+	// *errors.errorString: "timeout"
+	// true
+}
+
+func ExampleError_Depth() {
+	err := Push(
+		"can't deploy",
+		Context(
+			Errorf(errors.New("connection refused"), "can't connect"),
+			Context("host", "node-a"),
+		),
+		"timeout",
+	).(Error)
+
+	fmt.Println(err.Depth(), err.Count())
+	fmt.Println(Errorf(nil, "single").(Error).Depth())
+
+	// Output:
+	// 3 4
+	// 1
+}
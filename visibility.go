@@ -0,0 +1,108 @@
+package hierr
+
+import (
+	"sync"
+)
+
+// Visibility represents visibility of error node or context key for
+// external audience, like API clients and end-users.
+type Visibility int
+
+const (
+	// VisibilityDefault means that node is visible for external audience
+	// only if it has public message, and context key is not visible.
+	VisibilityDefault Visibility = iota
+
+	// VisibilityPublic means that node or context key is visible for
+	// external audience. Node without public message is shown with its
+	// message.
+	VisibilityPublic
+
+	// VisibilityPrivate means that node with all its nested reasons or
+	// context key is never visible for external audience.
+	VisibilityPrivate
+)
+
+// Audience represents audience, for which error is rendered by Render().
+type Audience int
+
+const (
+	// AudienceInternal is an audience of logs, which sees the whole tree.
+	AudienceInternal Audience = iota
+
+	// AudienceExternal is an audience of API clients and end-users, which
+	// sees only public nodes and context pairs with public keys.
+	AudienceExternal
+)
+
+var keyVisibility struct {
+	sync.RWMutex
+
+	keys map[string]Visibility
+}
+
+// SetKeyVisibility sets visibility of context pairs with given key for
+// external audience, context pairs are not visible by default.
+func SetKeyVisibility(key string, visibility Visibility) {
+	keyVisibility.Lock()
+	defer keyVisibility.Unlock()
+
+	if keyVisibility.keys == nil {
+		keyVisibility.keys = map[string]Visibility{}
+	}
+
+	keyVisibility.keys[key] = visibility
+}
+
+// GetKeyVisibility returns visibility of context pairs with given key.
+func GetKeyVisibility(key string) Visibility {
+	keyVisibility.RLock()
+	defer keyVisibility.RUnlock()
+
+	return keyVisibility.keys[key]
+}
+
+// resetKeyVisibility returns all context keys to default visibility.
+func resetKeyVisibility() {
+	keyVisibility.Lock()
+	defer keyVisibility.Unlock()
+
+	keyVisibility.keys = nil
+}
+
+// WithVisibility returns copy of the error with given visibility for
+// external audience.
+func (err Error) WithVisibility(visibility Visibility) Error {
+	err.Visibility = visibility
+
+	return err
+}
+
+// Render renders error for given audience, so one error value can be shown
+// in logs and to API clients without separate constructions. Internal
+// audience gets the same representation as Error() returns, external
+// audience gets the same representation as RenderPublic() returns.
+func Render(err error, audience Audience) string {
+	if audience == AudienceExternal {
+		return RenderPublic(err)
+	}
+
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
+
+// isPublic returns true if node is visible for external audience.
+func isPublic(err Error) bool {
+	switch err.Visibility {
+	case VisibilityPublic:
+		return true
+
+	case VisibilityPrivate:
+		return false
+	}
+
+	return err.PublicMessage != ""
+}
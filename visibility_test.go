@@ -0,0 +1,45 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleRender() {
+	defer resetKeyVisibility()
+
+	SetKeyVisibility("request", VisibilityPublic)
+
+	err := Push(
+		"can't handle request",
+		Errorf(errors.New("quota is exceeded"), "can't allocate volume").(Error).
+			WithVisibility(VisibilityPublic),
+		Errorf(errors.New("token is expired"), "can't refresh credentials").(Error).
+			WithVisibility(VisibilityPrivate).
+			WithPublicMessage("authentication failed"),
+		Context("request", "42"),
+		Context("user", "admin"),
+	).(Error).WithPublicMessage("request failed")
+
+	fmt.Println(Render(err, AudienceInternal))
+	fmt.Println(Render(err, AudienceExternal))
+
+	// Output:
+	// can't handle request
+	// ├─ can't allocate volume
+	// │  └─ quota is exceeded
+	// │
+	// ├─ can't refresh credentials
+	// │  └─ token is expired
+	// │
+	// ├─ request
+	// │  └─ 42
+	// │
+	// └─ user
+	//    └─ admin
+	// request failed
+	// ├─ can't allocate volume
+	// │
+	// └─ request
+	//    └─ 42
+}
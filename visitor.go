@@ -0,0 +1,66 @@
+package hierr
+
+// Node describes error and its position in the tree, which is passed to
+// visitor.
+type Node struct {
+	// Error is an error at this position. Reasons, which are not hierr
+	// errors, are converted into synthetic errors.
+	Error Error
+
+	// Reason is original reason at this position.
+	Reason NestedError
+
+	// Fields are context pairs of error.
+	Fields []Field
+
+	// Index is an index of node among its siblings.
+	Index int
+
+	// Last is true if node is the last one among its siblings.
+	Last bool
+}
+
+// Visitor represents object, which is notified about every node of the error
+// tree by Traverse().
+type Visitor interface {
+	// Enter is called before visiting nested reasons of node. If Enter
+	// returns false, nested reasons of node are not visited, but Leave is
+	// still called.
+	Enter(node Node, depth int) bool
+
+	// Leave is called after visiting all nested reasons of node.
+	Leave(node Node, depth int)
+}
+
+// Traverse visits every node of given error tree in order of descending into
+// the tree. Error itself is visited with zero depth. Context pairs are not
+// visited as separate nodes, they are passed as node fields.
+func Traverse(err NestedError, visitor Visitor) {
+	traverse(err, 0, 0, true, visitor)
+}
+
+func traverse(
+	reason NestedError,
+	depth int,
+	index int,
+	last bool,
+	visitor Visitor,
+) {
+	_, fields, reasons := Decompose(reason)
+
+	node := Node{
+		Error:  asError(reason),
+		Reason: reason,
+		Fields: fields,
+		Index:  index,
+		Last:   last,
+	}
+
+	if visitor.Enter(node, depth) {
+		for index, nested := range reasons {
+			traverse(nested, depth+1, index, index == len(reasons)-1, visitor)
+		}
+	}
+
+	visitor.Leave(node, depth)
+}
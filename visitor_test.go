@@ -0,0 +1,64 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type outline struct {
+	prefixes []string
+}
+
+func (outline *outline) Enter(node Node, depth int) bool {
+	marker := ""
+	if depth > 0 {
+		marker = "+ "
+		if node.Last {
+			marker = "` "
+		}
+	}
+
+	fmt.Print(strings.Join(outline.prefixes, "") + marker + node.Error.Message)
+	for _, field := range node.Fields {
+		fmt.Printf(" [%s=%v]", field.Key, field.Value)
+	}
+	fmt.Println()
+
+	prefix := ""
+	if depth > 0 {
+		prefix = "| "
+		if node.Last {
+			prefix = "  "
+		}
+	}
+
+	outline.prefixes = append(outline.prefixes, prefix)
+
+	return !strings.HasPrefix(node.Error.Message, "skip")
+}
+
+func (outline *outline) Leave(node Node, depth int) {
+	outline.prefixes = outline.prefixes[:len(outline.prefixes)-1]
+}
+
+func ExampleTraverse() {
+	err := Push(
+		"can't deploy",
+		Context(
+			Errorf(errors.New("connection refused"), "can't connect"),
+			Context("host", "node-a"),
+		),
+		Errorf(errors.New("hidden"), "skipped"),
+		"timeout",
+	)
+
+	Traverse(err, &outline{})
+
+	// Output:
+	// can't deploy
+	// + can't connect [host=node-a]
+	// | ` connection refused
+	// + skipped
+	// ` timeout
+}
@@ -0,0 +1,49 @@
+package hierr
+
+import (
+	"fmt"
+)
+
+// Wrap creates new hierarchy error with given message and specified error
+// as reason, or returns nil if error is nil, so result of function can be
+// wrapped and returned without checking it first:
+//
+//	return hierr.Wrap(pull(remote), "can't pull remote")
+//
+// Message is used as is, without formatting.
+func Wrap(err error, message string) error {
+	if err == nil {
+		return nil
+	}
+
+	return newError(1, false, err, message)
+}
+
+// Wrapf creates new hierarchy error as Wrap() does, but formats message
+// according to format specifier.
+func Wrapf(err error, message string, args ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+
+	return newError(1, false, err, fmt.Sprintf(message, args...))
+}
+
+// DeferWrapf wraps error, referenced by given pointer, into new hierarchy
+// error with formatted message, if error is not nil. It's intended to wrap
+// named result of function on the way out:
+//
+//	func process(name string) (err error) {
+//		defer hierr.DeferWrapf(&err, "can't process %s", name)
+//		...
+//	}
+//
+// It has the same semantics as Wrapf(), which name is already taken by
+// non-deferred variant.
+func DeferWrapf(err *error, message string, args ...interface{}) {
+	if err == nil || *err == nil {
+		return
+	}
+
+	*err = newError(1, false, *err, fmt.Sprintf(message, args...))
+}
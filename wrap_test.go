@@ -0,0 +1,47 @@
+package hierr
+
+import (
+	"errors"
+	"fmt"
+)
+
+func ExampleWrap() {
+	pull := func(remote string) error {
+		if remote == "origin" {
+			return nil
+		}
+
+		return errors.New("exit status 128")
+	}
+
+	fmt.Println(Wrap(pull("origin"), "can't pull remote"))
+	fmt.Println(Wrap(pull("upstream"), "can't pull remote at 100%"))
+	fmt.Println(Wrapf(pull("upstream"), "can't pull remote %q", "upstream"))
+
+	// Output:
+	// <nil>
+	// can't pull remote at 100%
+	// └─ exit status 128
+	// can't pull remote "upstream"
+	// └─ exit status 128
+}
+
+func ExampleDeferWrapf() {
+	process := func(name string, fail bool) (err error) {
+		defer DeferWrapf(&err, "can't process %s", name)
+
+		if fail {
+			return errors.New("permission denied")
+		}
+
+		return nil
+	}
+
+	fmt.Println(process("config.toml", false))
+	fmt.Println(process("config.toml", true))
+
+	// Output:
+	// <nil>
+	// can't process config.toml
+	// └─ permission denied
+}